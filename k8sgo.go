@@ -1,16 +1,36 @@
 package main
 
 import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"flag"
 	"fmt"
+	"html"
+	"io"
+	"io/fs"
 	"log"
+	"net"
+	"net/http"
+	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"text/template"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -20,12 +40,31 @@ import (
 	openshiftclient "github.com/openshift/client-go/apps/clientset/versioned"
 	routeclient "github.com/openshift/client-go/route/clientset/versioned"
 	projectclient "github.com/openshift/client-go/project/clientset/versioned"
+	appsv1 "k8s.io/api/apps/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/homedir"
+	"sigs.k8s.io/yaml"
 )
 
 // ASCII Banner for k8sGo
@@ -46,6 +85,7 @@ const (
 	LogFrame                 // Logs display frame
 	EventFrame               // Events display frame
 	DetailFrame              // Detail information frame
+	MetricsFrame             // Metric history (sparklines) frame
 )
 
 // ViewType represents different view modes in the application
@@ -60,6 +100,15 @@ const (
 	LogView                                // Resource logs view with scrolling
 	EventView                              // Resource events view for errors/warnings
 	MultiFrameView                         // Multi-frame layout for resources and logs
+	CronJobHistoryView                     // Jobs and pods spawned by a CronJob
+	OwnershipTreeView                      // ownerReferences tree around a resource
+	TrafficPathView                        // Ingress/Route → Service → EndpointSlice → Pod path
+	DashboardView                          // Cluster-wide health summary across all namespaces
+	ConfigView                             // Effective configuration
+	NotificationsView                      // History of webhook notifications
+	ChangelogView                          // Transitions of a resource list between refreshes
+	CompareView                            // Drift between the objects of two namespaces or contexts
+	CompareDiffView                        // Field-level diff of one object between the compared sides
 )
 
 // ResourceScope defines whether resource is cluster-scoped or namespace-scoped
@@ -184,8 +233,44 @@ type K8sResource struct {
 	Age          string
 	Details      map[string]string // Additional resource-specific details
 	ResourceType ResourceType      // Type of resource for logs functionality
-	Errors       []string          // List of errors/issues with this resource
-	Warnings     []string          // List of warnings for this resource
+	Errors       []string          // Error findings as display text, built by RuleSet.apply
+	Warnings     []string          // Warning findings as display text, built by RuleSet.apply
+	Object       runtime.Object    // Live API object the resource was built from (may be nil)
+	Usage        *UsageMetrics     // Live CPU/memory usage from metrics.k8s.io (nil if unavailable)
+	Findings     []RuleFinding     // Health rules that fired
+	RuleData     map[string]interface{} // Values rules read next to the object's fields, e.g. endpoints.ready
+}
+
+// UsageMetrics holds live CPU/memory usage reported by metrics.k8s.io
+type UsageMetrics struct {
+	Name           string // Container name for per-container usage
+	CPU            resource.Quantity
+	Memory         resource.Quantity
+	CPUPercent     int // Share of requests (pods) or allocatable (nodes), -1 without a baseline
+	MemoryPercent  int
+	CPULimitPct    int // Share of limits (pods only), -1 without limits
+	MemoryLimitPct int
+	Containers     []UsageMetrics
+}
+
+// ruleData returns the usage as rules see it: usage.cpuPercent, usage.containers[*].memoryLimitPercent, ...
+func (u *UsageMetrics) ruleData() map[string]interface{} {
+	data := map[string]interface{}{
+		"cpuPercent":         int64(u.CPUPercent),
+		"memoryPercent":      int64(u.MemoryPercent),
+		"cpuLimitPercent":    int64(u.CPULimitPct),
+		"memoryLimitPercent": int64(u.MemoryLimitPct),
+	}
+	if len(u.Containers) > 0 {
+		containers := make([]interface{}, 0, len(u.Containers))
+		for i := range u.Containers {
+			container := u.Containers[i].ruleData()
+			container["name"] = u.Containers[i].Name
+			containers = append(containers, container)
+		}
+		data["containers"] = containers
+	}
+	return data
 }
 
 // LogEntry represents a single log line
@@ -220,23 +305,65 @@ type ColorScheme struct {
 	Muted       lipgloss.Color
 }
 
-var colors = ColorScheme{
-	Primary:    lipgloss.Color("#4A9EFF"), // Soft Blue
-	Secondary:  lipgloss.Color("#FF8C42"), // Warm Orange
-	Success:    lipgloss.Color("#28A745"), // Forest Green
-	Warning:    lipgloss.Color("#FFC107"), // Amber
-	Error:      lipgloss.Color("#DC3545"), // Muted Red
-	Info:       lipgloss.Color("#17A2B8"), // Teal
-	Accent:     lipgloss.Color("#6F42C1"), // Deep Purple
-	Background: lipgloss.Color("#1E1E1E"), // Dark Gray
-	Text:       lipgloss.Color("#E5E5E5"), // Light Gray
-	Muted:      lipgloss.Color("#3A3A3A"), // Darker Gray for dividers and header
+// builtinThemes are the themes that ship with k8sGo
+var builtinThemes = map[string]ColorScheme{
+	"dark": {
+		Primary:    lipgloss.Color("#4A9EFF"), // Soft Blue
+		Secondary:  lipgloss.Color("#FF8C42"), // Warm Orange
+		Success:    lipgloss.Color("#28A745"), // Forest Green
+		Warning:    lipgloss.Color("#FFC107"), // Amber
+		Error:      lipgloss.Color("#DC3545"), // Muted Red
+		Info:       lipgloss.Color("#17A2B8"), // Teal
+		Accent:     lipgloss.Color("#6F42C1"), // Deep Purple
+		Background: lipgloss.Color("#1E1E1E"), // Dark Gray
+		Text:       lipgloss.Color("#E5E5E5"), // Light Gray
+		Muted:      lipgloss.Color("#3A3A3A"), // Darker Gray for dividers and header
+	},
+	"light": {
+		Primary:    lipgloss.Color("#0059C1"), // Deep Blue
+		Secondary:  lipgloss.Color("#C25400"), // Burnt Orange
+		Success:    lipgloss.Color("#1E7E34"), // Dark Green
+		Warning:    lipgloss.Color("#9A6700"), // Ochre
+		Error:      lipgloss.Color("#B02A37"), // Dark Red
+		Info:       lipgloss.Color("#0E7C8C"), // Dark Teal
+		Accent:     lipgloss.Color("#5A32A3"), // Purple
+		Background: lipgloss.Color("#FAFAFA"), // Off White
+		Text:       lipgloss.Color("#1F1F1F"), // Near Black
+		Muted:      lipgloss.Color("#D0D0D0"), // Light Gray for dividers and header
+	},
+	"high-contrast": {
+		Primary:    lipgloss.Color("#00FFFF"), // Cyan
+		Secondary:  lipgloss.Color("#FFFF00"), // Yellow
+		Success:    lipgloss.Color("#00FF00"), // Green
+		Warning:    lipgloss.Color("#FFD700"), // Gold
+		Error:      lipgloss.Color("#FF0000"), // Red
+		Info:       lipgloss.Color("#00FFFF"), // Cyan
+		Accent:     lipgloss.Color("#FF00FF"), // Magenta
+		Background: lipgloss.Color("#000000"), // Black
+		Text:       lipgloss.Color("#FFFFFF"), // White
+		Muted:      lipgloss.Color("#767676"), // Gray readable on black and under white text
+	},
+}
+
+// defaultTheme follows the terminal background
+const defaultTheme = "auto"
+
+// colors is the active theme; render functions read it on every frame
+var colors = builtinThemes["dark"]
+
+// selection returns the style of a highlighted row on background bg; terminals without colors get reverse video
+func (c ColorScheme) selection(bg lipgloss.Color) lipgloss.Style {
+	style := lipgloss.NewStyle().Bold(true).Foreground(c.Background).Background(bg)
+	if lipgloss.ColorProfile() == termenv.Ascii {
+		style = style.Reverse(true)
+	}
+	return style
 }
 
 // Model represents the application state using Bubble Tea pattern
 type Model struct {
 	// Kubernetes client
-	clientset *kubernetes.Clientset
+	clientset kubernetes.Interface // A fake clientset in tests and offline modes
 	ctx       context.Context
 	
 	// OpenShift clients (optional, will be nil if not available)
@@ -264,7 +391,10 @@ type Model struct {
 	resourceTypes       []ResourceType
 	resources           []K8sResource
 	logEntries          []LogEntry
+	logContainer        string        // Container whose logs are shown; kept for the next pod if it has one by that name
+	logContainers       []string      // Containers of the pod whose logs are shown
 	eventEntries        []EventEntry
+	historyEntries      []K8sResource // Jobs and pods of the CronJob in CronJobHistoryView
 	
 	// Current selections
 	selectedKubeContext  string         // Selected Kubernetes context
@@ -272,43 +402,125 @@ type Model struct {
 	selectedResource     ResourceType
 	selectedK8sResource  *K8sResource   // For logs/events
 	selectedScope        ResourceScope  // Cluster or namespace scoped
+	historyCronJob       *K8sResource   // CronJob whose run history is shown
+	ownershipTarget      *K8sResource   // Resource the ownership tree was opened for
+	ownershipRows        []OwnerTreeRow
+	ownershipOrigin      int            // Row of ownershipTarget in ownershipRows
+	ownershipSkipped     []string       // Kinds missing from the tree because listing them is forbidden
+	trafficTarget        *K8sResource   // Service, Ingress or Route the traffic path was opened for
+	trafficRows          []TrafficPathRow
+	clusterHealth        *ClusterHealth // Result of the last cluster health scan
+	comparison           *Comparison    // Result of the last drift comparison
+	diffKind             ResourceType   // Kind and name of the comparison row shown in CompareDiffView
+	diffName             string
+	focusResource        string         // Resource to put the cursor on once DetailView has loaded
+	
+	// Label/field selectors per resource type, kept for the session and shared across model copies
+	selectors map[ResourceType]ResourceSelector
+	
+	// Table sort order per resource type, kept for the session and shared across model copies
+	sortOrders map[ResourceType]tableSort
+	
+	// Usage metrics (metrics.k8s.io)
+	metricsAvailable bool
+	metricsStatus    string                   // Why metrics are unavailable, shown instead of an error
+	metricHistory    map[string]*MetricSeries // Rolling in-session history per pod/node, shared across model copies
+	
+	// Health rules applied to every loaded resource
+	rules *RuleSet
+	
+	// Preferences from the config file
+	config        *AppConfig
+	themeOverride string // Theme picked at runtime, overriding the configured one
+	keymap        Keymap // Key bindings per view, with the config overrides applied
+	
+	// Command-line flags
+	readOnly        bool          // --readonly: actions that change the cluster are denied
+	refreshOverride time.Duration // --refresh: overrides the configured refresh interval
+	refreshDisabled bool          // --refresh 0: auto-refresh stays off whatever the context configures
+	startContext    string        // --context: context selected on startup
+	startCommand    string        // --namespace/--resource: palette command run once startContext is connected
+	startSelector   string        // --selector: label selector for the --resource list
+	
+	// RBAC permissions per context and namespace, shared across model copies
+	permissionCache map[string]*permissionSet
 	
 	// UI state
 	width         int
 	height        int
 	loading       bool
 	errorMessage  string
+	statusMessage string // Result of the last user action (trigger, suspend, ...)
+	noticeMessage string // Informational note, e.g. why a fallback namespace list is shown
+	inputMode     string // Active inline text input, empty when none
+	showHelp      bool   // '?' overlay listing the key bindings of the current view
+	inputBuffer   string
+	inputError    string // Validation error of inputBuffer, shown below the prompt
+	filterQuery   string   // Incremental '/' filter over the list in filterView
+	filterView    ViewType
+	
+	// ':' command palette
+	commandHistory       []string // Commands run this session, oldest first
+	historyPos           int      // Entry of commandHistory being recalled, -1 for a new line
+	completionBase       string   // Command line before Tab completion started cycling
+	completionIndex      int
+	shortNames           map[string]ResourceType // Kind short names from discovery, nil until loaded
+	completionNamespaces []string
 	lastUpdate    time.Time
 	
 	// Auto-refresh
 	autoRefresh   bool
 	refreshTicker *time.Ticker
+	
+	// Webhook notifications about watched resources, shared across model copies
+	notifications *notificationState
+	
+	// Differences between successive loads of the resource list, shared across model copies
+	changes       *changeTracker
+	changelogList string // List whose changelog ChangelogView shows
+	
+	// Snapshots: the recording in progress, or the snapshot replayed instead of a cluster (--replay)
+	recorder    *snapshotRecorder
+	replay      *replayState
+	replayFrame int // Recorded point in time shown, an index into replay.snapshot.Frames
 }
 
 // Init initializes the model - required by Bubble Tea
 func (m Model) Init() tea.Cmd {
 	return tea.Batch(
 		m.loadKubernetesContexts(),
-		tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
-			return refreshMsg{}
-		}),
+		m.scheduleRefresh(),
+		m.scheduleNotificationPoll(),
 	)
 }
 
+// scheduleRefresh arms the next auto-refresh tick
+func (m Model) scheduleRefresh() tea.Cmd {
+	return tea.Tick(m.settings().RefreshInterval, func(t time.Time) tea.Msg {
+		return refreshMsg{}
+	})
+}
+
 // Messages for Bubble Tea messaging system
 type namespacesLoadedMsg struct {
 	namespaces []string
+	notice     string // Set when namespaces came from a fallback because listing them is forbidden
 	err        error
 }
 
 type resourcesLoadedMsg struct {
-	resources []K8sResource
-	err       error
+	resources  []K8sResource
+	err        error
+	metricsErr error // Why usage metrics could not be attached (pods and nodes only)
 }
 
 type logsLoadedMsg struct {
-	logs []LogEntry
-	err  error
+	logs       []LogEntry
+	pod        string   // namespace/name of the pod
+	container  string   // Container whose logs were read; empty for recorded logs
+	containers []string // Containers of the pod
+	raw        string   // Log text as returned by the API, kept for snapshots
+	err        error
 }
 
 type eventsLoadedMsg struct {
@@ -328,9 +540,50 @@ type contextSwitchedMsg struct {
 	err         error
 }
 
+type paletteDataLoadedMsg struct {
+	shortNames map[string]ResourceType
+	namespaces []string
+}
+
+type execFinishedMsg struct {
+	err error
+}
+
+type cronJobActionMsg struct {
+	message string
+	err     error
+}
+
+type cronJobHistoryLoadedMsg struct {
+	entries []K8sResource
+	err     error
+}
+
+type ownershipTreeLoadedMsg struct {
+	rows    []OwnerTreeRow
+	origin  int      // Row of the resource the tree was opened for
+	skipped []string // Kinds left out because listing them is forbidden
+	err     error
+}
+
+type permissionsLoadedMsg struct {
+	key         string // permissionKey of the checked context and namespace
+	permissions *permissionSet
+}
+
+type clusterHealthLoadedMsg struct {
+	health *ClusterHealth
+	err    error
+}
+
+type trafficPathLoadedMsg struct {
+	rows []TrafficPathRow
+	err  error
+}
+
 type clientsReinitializedMsg struct {
 	contextName         string
-	clientset          *kubernetes.Clientset
+	clientset          kubernetes.Interface
 	openshiftAppsClient *openshiftclient.Clientset
 	routeClient        *routeclient.Clientset
 	projectClient      *projectclient.Clientset
@@ -368,6 +621,11 @@ func isOpenShiftContext(contextName string) bool {
 // loadKubernetesContexts creates a command to load all available contexts
 func (m Model) loadKubernetesContexts() tea.Cmd {
 	return func() tea.Msg {
+		if m.replay != nil {
+			// A replayed snapshot holds a single context
+			return kubeContextsLoadedMsg{contexts: []string{m.replay.snapshot.Context}}
+		}
+		
 		// Load all contexts from kubeconfig
 		config, err := clientcmd.NewDefaultClientConfigLoadingRules().Load()
 		if err != nil {
@@ -398,6 +656,10 @@ func (m Model) loadKubernetesContexts() tea.Cmd {
 // switchKubernetesContext switches to the selected context using kubectl
 func (m Model) switchKubernetesContext(contextName string) tea.Cmd {
 	return func() tea.Msg {
+		if m.replay != nil {
+			return contextSwitchedMsg{contextName: contextName}
+		}
+		
 		// Execute kubectl config use-context command
 		cmd := exec.Command("kubectl", "config", "use-context", contextName)
 		if err := cmd.Run(); err != nil {
@@ -418,6 +680,10 @@ func execCommand(name string, args ...string) error {
 func (m Model) loadNamespaces() tea.Cmd {
 	return func() tea.Msg {
 		nsList, err := m.clientset.CoreV1().Namespaces().List(m.ctx, metav1.ListOptions{})
+		if apierrors.IsForbidden(err) {
+			namespaces, notice := m.fallbackNamespaces()
+			return namespacesLoadedMsg{namespaces: namespaces, notice: notice}
+		}
 		if err != nil {
 			return namespacesLoadedMsg{err: err}
 		}
@@ -432,84 +698,389 @@ func (m Model) loadNamespaces() tea.Cmd {
 	}
 }
 
-// loadResources creates a command to asynchronously load resources for current namespace and type
-func (m Model) loadResources() tea.Cmd {
+// apiResource returns the API group and resource name used in RBAC rules for a resource type
+func (rt ResourceType) apiResource() (string, string) {
+	apiResources := map[ResourceType][2]string{
+		NodesResource:                    {"", "nodes"},
+		PersistentVolumesResource:        {"", "persistentvolumes"},
+		StorageClassesResource:           {"storage.k8s.io", "storageclasses"},
+		ClusterRolesResource:             {"rbac.authorization.k8s.io", "clusterroles"},
+		PodsResource:                     {"", "pods"},
+		ServicesResource:                 {"", "services"},
+		DeploymentsResource:              {"apps", "deployments"},
+		ConfigMapsResource:               {"", "configmaps"},
+		SecretsResource:                  {"", "secrets"},
+		IngressResource:                  {"networking.k8s.io", "ingresses"},
+		PersistentVolumeClaimsResource:   {"", "persistentvolumeclaims"},
+		ReplicaSetsResource:              {"apps", "replicasets"},
+		DaemonSetsResource:               {"apps", "daemonsets"},
+		StatefulSetsResource:             {"apps", "statefulsets"},
+		JobsResource:                     {"batch", "jobs"},
+		CronJobsResource:                 {"batch", "cronjobs"},
+		EventsResource:                   {"", "events"},
+		RoutesResource:                   {"route.openshift.io", "routes"},
+		DeploymentConfigsResource:        {"apps.openshift.io", "deploymentconfigs"},
+		ProjectsResource:                 {"project.openshift.io", "projects"},
+		BuildConfigsResource:             {"build.openshift.io", "buildconfigs"},
+		BuildsResource:                   {"build.openshift.io", "builds"},
+		ImageStreamsResource:             {"image.openshift.io", "imagestreams"},
+		GatewaysResource:                 {"gateway.networking.k8s.io", "gateways"},
+		HTTPRoutesResource:               {"gateway.networking.k8s.io", "httproutes"},
+		GatewayClassesResource:           {"gateway.networking.k8s.io", "gatewayclasses"},
+		NetworkPoliciesResource:          {"networking.k8s.io", "networkpolicies"},
+		HorizontalPodAutoscalersResource: {"autoscaling", "horizontalpodautoscalers"},
+		VerticalPodAutoscalersResource:   {"autoscaling.k8s.io", "verticalpodautoscalers"},
+	}
+	res := apiResources[rt]
+	return res[0], res[1]
+}
+
+// accessCheck is a single verb on a resource that a menu entry or action needs
+type accessCheck struct {
+	Verb     string
+	Group    string
+	Resource string // May include a subresource, e.g. "pods/log"
+}
+
+// accessDecision is the outcome of an accessCheck; Reason explains a denial
+type accessDecision struct {
+	Allowed bool
+	Reason  string
+}
+
+// permissionSet holds the access decisions for one context and namespace
+type permissionSet struct {
+	Resources map[ResourceType]accessDecision
+	Actions   map[string]accessDecision // Keyed by action: "logs", "events", "trigger", "suspend", "exec"
+}
+
+// actionChecks lists the permissions needed by resource actions
+var actionChecks = map[string]accessCheck{
+	"logs":    {Verb: "get", Group: "", Resource: "pods/log"},
+	"events":  {Verb: "list", Group: "", Resource: "events"},
+	"trigger": {Verb: "create", Group: "batch", Resource: "jobs"},
+	"suspend": {Verb: "patch", Group: "batch", Resource: "cronjobs"},
+	"exec":    {Verb: "create", Group: "", Resource: "pods/exec"},
+}
+
+// permissionKey identifies a permission set by context, scope and namespace. Cluster scope and all namespaces
+// both check without a namespace but cover different resource types, so they are kept apart.
+func permissionKey(contextName string, scope ResourceScope, namespace string) string {
+	if scope == ClusterScoped {
+		return contextName + "|cluster"
+	}
+	if namespace == metav1.NamespaceAll {
+		return contextName + "|all-namespaces"
+	}
+	return contextName + "/" + namespace
+}
+
+// describeDenial explains which verb, resource and group a denied check is missing
+func describeDenial(check accessCheck, reason string) string {
+	group := check.Group
+	if group == "" {
+		group = "core"
+	}
+	text := fmt.Sprintf("missing verb %q on %q in API group %q", check.Verb, check.Resource, group)
+	if reason != "" {
+		text += " (" + reason + ")"
+	}
+	return text
+}
+
+// checkPermissions evaluates list access for resource types of the selected scope and, in a namespace,
+// the resource actions.
+// A SelfSubjectRulesReview answers namespaced checks in one call; checks it cannot decide and
+// cluster-scoped checks fall back to a SelfSubjectAccessReview each.
+func (m Model) checkPermissions(namespace string, resourceTypes []ResourceType) tea.Cmd {
 	return func() tea.Msg {
-		var resources []K8sResource
-		var err error
-		
-		switch m.selectedResource {
-		// Cluster-scoped resources
-		case NodesResource:
-			resources, err = m.loadNodes()
-		case PersistentVolumesResource:
-			resources, err = m.loadPersistentVolumes()
-		case StorageClassesResource:
-			resources, err = m.loadStorageClasses()
-		case ClusterRolesResource:
-			resources, err = m.loadClusterRoles()
+		var rules []authorizationv1.ResourceRule
+		rulesComplete := false
+		if namespace != "" {
+			review, err := m.clientset.AuthorizationV1().SelfSubjectRulesReviews().Create(m.ctx, &authorizationv1.SelfSubjectRulesReview{
+				Spec: authorizationv1.SelfSubjectRulesReviewSpec{Namespace: namespace},
+			}, metav1.CreateOptions{})
+			if err == nil {
+				rules = review.Status.ResourceRules
+				rulesComplete = !review.Status.Incomplete
+			}
+		}
 		
-		// Namespace-scoped resources
-		case PodsResource:
-			resources, err = m.loadPods()
-		case ServicesResource:
-			resources, err = m.loadServices()
-		case DeploymentsResource:
-			resources, err = m.loadDeployments()
-		case ConfigMapsResource:
-			resources, err = m.loadConfigMaps()
-		case SecretsResource:
-			resources, err = m.loadSecrets()
-		case IngressResource:
-			resources, err = m.loadIngress()
-		case PersistentVolumeClaimsResource:
-			resources, err = m.loadPersistentVolumeClaims()
-		case ReplicaSetsResource:
-			resources, err = m.loadReplicaSets()
-		case DaemonSetsResource:
-			resources, err = m.loadDaemonSets()
-		case StatefulSetsResource:
-			resources, err = m.loadStatefulSets()
-		case JobsResource:
-			resources, err = m.loadJobs()
-		case CronJobsResource:
-			resources, err = m.loadCronJobs()
-		case EventsResource:
-			resources, err = m.loadEventsResource()
+		decide := func(check accessCheck) accessDecision {
+			if rulesAllow(rules, check) {
+				return accessDecision{Allowed: true}
+			}
+			if rulesComplete {
+				return accessDecision{Reason: describeDenial(check, "")}
+			}
+			return m.reviewAccess(namespace, check)
+		}
 		
-		// OpenShift-specific resources
-		case RoutesResource:
-			resources, err = m.loadRoutes()
-		case DeploymentConfigsResource:
-			resources, err = m.loadDeploymentConfigs()
-		case ProjectsResource:
-			resources, err = m.loadProjects()
-		case BuildConfigsResource:
-			resources, err = m.loadBuildConfigs()
-		case BuildsResource:
-			resources, err = m.loadBuilds()
-		case ImageStreamsResource:
-			resources, err = m.loadImageStreams()
+		perms := &permissionSet{
+			Resources: map[ResourceType]accessDecision{},
+			Actions:   map[string]accessDecision{},
+		}
+		for _, rt := range resourceTypes {
+			group, res := rt.apiResource()
+			if res == "" {
+				continue
+			}
+			perms.Resources[rt] = decide(accessCheck{Verb: "list", Group: group, Resource: res})
+		}
+		if namespace != "" {
+			for action, check := range actionChecks {
+				perms.Actions[action] = decide(check)
+			}
+		}
 		
-		// Gateway API resources
-		case GatewaysResource:
-			resources, err = m.loadGateways()
-		case HTTPRoutesResource:
-			resources, err = m.loadHTTPRoutes()
-		case GatewayClassesResource:
-			resources, err = m.loadGatewayClasses()
+		return permissionsLoadedMsg{key: permissionKey(m.selectedKubeContext, m.selectedScope, namespace), permissions: perms}
+	}
+}
+
+// reviewAccess asks the API server whether the current user may perform a single check
+func (m Model) reviewAccess(namespace string, check accessCheck) accessDecision {
+	resourceName, subresource, _ := strings.Cut(check.Resource, "/")
+	review, err := m.clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(m.ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace:   namespace,
+				Verb:        check.Verb,
+				Group:       check.Group,
+				Resource:    resourceName,
+				Subresource: subresource,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		// Unknown: let the request itself report the problem
+		return accessDecision{Allowed: true}
+	}
+	if review.Status.Allowed {
+		return accessDecision{Allowed: true}
+	}
+	return accessDecision{Reason: describeDenial(check, review.Status.Reason)}
+}
+
+// rulesAllow reports whether any rule of a SelfSubjectRulesReview grants check
+func rulesAllow(rules []authorizationv1.ResourceRule, check accessCheck) bool {
+	resourceName, subresource, _ := strings.Cut(check.Resource, "/")
+	for _, rule := range rules {
+		// Rules limited to named objects never grant list/create on the collection
+		if len(rule.ResourceNames) > 0 {
+			continue
+		}
+		if !containsOrWildcard(rule.Verbs, check.Verb) || !containsOrWildcard(rule.APIGroups, check.Group) {
+			continue
+		}
+		for _, res := range rule.Resources {
+			if res == "*" || res == check.Resource ||
+				(subresource != "" && (res == resourceName+"/*" || res == "*/"+subresource)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// containsOrWildcard reports whether values contains value or "*"
+func containsOrWildcard(values []string, value string) bool {
+	for _, v := range values {
+		if v == value || v == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// permissions returns the cached permission set for the current context and namespace, if checked
+func (m Model) permissions() *permissionSet {
+	namespace := ""
+	if m.selectedScope == NamespaceScoped {
+		namespace = m.selectedNamespace
+	}
+	return m.permissionCache[permissionKey(m.selectedKubeContext, m.selectedScope, namespace)]
+}
+
+// resourceAccess returns whether the current user may list a resource type; unchecked types are allowed
+func (m Model) resourceAccess(rt ResourceType) accessDecision {
+	if perms := m.permissions(); perms != nil {
+		if decision, ok := perms.Resources[rt]; ok {
+			return decision
+		}
+	}
+	return accessDecision{Allowed: true}
+}
+
+// actionAccess returns whether the current user may perform a resource action; unchecked actions are allowed
+func (m Model) actionAccess(action string) accessDecision {
+	if check, ok := actionChecks[action]; ok && check.Verb != "get" && check.Verb != "list" {
+		if m.replay != nil {
+			return accessDecision{Reason: "browsing recorded cluster state, not a live cluster"}
+		}
+		if m.readOnly {
+			return accessDecision{Reason: "read-only mode (--readonly)"}
+		}
+	}
+	if perms := m.permissions(); perms != nil {
+		if decision, ok := perms.Actions[action]; ok {
+			return decision
+		}
+	}
+	return accessDecision{Allowed: true}
+}
+
+// fallbackNamespaces lists the namespaces a user without cluster-wide namespace access can use:
+// the OpenShift projects visible to them, or else the context's default namespace
+func (m Model) fallbackNamespaces() ([]string, string) {
+	if m.projectClient != nil {
+		projects, err := m.projectClient.ProjectV1().Projects().List(m.ctx, metav1.ListOptions{})
+		if err == nil && len(projects.Items) > 0 {
+			var namespaces []string
+			for _, project := range projects.Items {
+				namespaces = append(namespaces, project.Name)
+			}
+			sort.Strings(namespaces)
+			return namespaces, "Listing namespaces is forbidden; showing your OpenShift projects"
+		}
+	}
+	
+	namespace := "default"
+	if config, err := clientcmd.NewDefaultClientConfigLoadingRules().Load(); err == nil {
+		contextName := m.selectedKubeContext
+		if contextName == "" {
+			contextName = config.CurrentContext
+		}
+		if kubeContext, ok := config.Contexts[contextName]; ok && kubeContext.Namespace != "" {
+			namespace = kubeContext.Namespace
+		}
+	}
+	return []string{namespace}, fmt.Sprintf("Listing namespaces is forbidden; using the context's default namespace %q", namespace)
+}
+
+// loadResources creates a command to asynchronously load resources for current namespace and type
+func (m Model) loadResources() tea.Cmd {
+	return func() tea.Msg {
+		var metricsErr error
 		
-		// Additional resources
-		case NetworkPoliciesResource:
-			resources, err = m.loadNetworkPolicies()
-		case HorizontalPodAutoscalersResource:
-			resources, err = m.loadHorizontalPodAutoscalers()
-		case VerticalPodAutoscalersResource:
-			resources, err = m.loadVerticalPodAutoscalers()
+		resources, err := m.fetchResources(m.selectedResource)
+		if err == nil {
+			switch m.selectedResource {
+			case NodesResource:
+				metricsErr = m.attachNodeMetrics(resources)
+			case PodsResource:
+				metricsErr = m.attachPodMetrics(resources)
+			}
 		}
 		
-		return resourcesLoadedMsg{resources: resources, err: err}
+		return resourcesLoadedMsg{resources: resources, err: err, metricsErr: metricsErr}
+	}
+}
+
+// placeholderLoaders are the resource types whose loaders are not implemented yet and always list nothing
+var placeholderLoaders = map[ResourceType]bool{
+	PersistentVolumesResource: true, StorageClassesResource: true, ClusterRolesResource: true,
+	ConfigMapsResource: true, SecretsResource: true, EventsResource: true,
+	BuildConfigsResource: true, BuildsResource: true, ImageStreamsResource: true,
+	GatewaysResource: true, HTTPRoutesResource: true, GatewayClassesResource: true,
+	NetworkPoliciesResource: true, HorizontalPodAutoscalersResource: true, VerticalPodAutoscalersResource: true,
+}
+
+// hasLoader reports whether k8sGo can list a resource type
+func hasLoader(rt ResourceType) bool {
+	return !placeholderLoaders[rt]
+}
+
+// isConnectionError reports whether err means the API server cannot be reached at all, as opposed to
+// an API error for a single request
+func isConnectionError(err error) bool {
+	var status apierrors.APIStatus
+	if errors.As(err, &status) {
+		return false
+	}
+	var dnsErr *net.DNSError
+	var opErr *net.OpError
+	return errors.As(err, &dnsErr) || errors.As(err, &opErr)
+}
+
+// fetchResources runs the loader for a resource type in the selected namespace and applies the health rules
+func (m Model) fetchResources(rt ResourceType) ([]K8sResource, error) {
+	var resources []K8sResource
+	var err error
+	
+	switch rt {
+	// Cluster-scoped resources
+	case NodesResource:
+		resources, err = m.loadNodes()
+	case PersistentVolumesResource:
+		resources, err = m.loadPersistentVolumes()
+	case StorageClassesResource:
+		resources, err = m.loadStorageClasses()
+	case ClusterRolesResource:
+		resources, err = m.loadClusterRoles()
+	
+	// Namespace-scoped resources
+	case PodsResource:
+		resources, err = m.loadPods()
+	case ServicesResource:
+		resources, err = m.loadServices()
+	case DeploymentsResource:
+		resources, err = m.loadDeployments()
+	case ConfigMapsResource:
+		resources, err = m.loadConfigMaps()
+	case SecretsResource:
+		resources, err = m.loadSecrets()
+	case IngressResource:
+		resources, err = m.loadIngress()
+	case PersistentVolumeClaimsResource:
+		resources, err = m.loadPersistentVolumeClaims()
+	case ReplicaSetsResource:
+		resources, err = m.loadReplicaSets()
+	case DaemonSetsResource:
+		resources, err = m.loadDaemonSets()
+	case StatefulSetsResource:
+		resources, err = m.loadStatefulSets()
+	case JobsResource:
+		resources, err = m.loadJobs()
+	case CronJobsResource:
+		resources, err = m.loadCronJobs()
+	case EventsResource:
+		resources, err = m.loadEventsResource()
+	
+	// OpenShift-specific resources
+	case RoutesResource:
+		resources, err = m.loadRoutes()
+	case DeploymentConfigsResource:
+		resources, err = m.loadDeploymentConfigs()
+	case ProjectsResource:
+		resources, err = m.loadProjects()
+	case BuildConfigsResource:
+		resources, err = m.loadBuildConfigs()
+	case BuildsResource:
+		resources, err = m.loadBuilds()
+	case ImageStreamsResource:
+		resources, err = m.loadImageStreams()
+	
+	// Gateway API resources
+	case GatewaysResource:
+		resources, err = m.loadGateways()
+	case HTTPRoutesResource:
+		resources, err = m.loadHTTPRoutes()
+	case GatewayClassesResource:
+		resources, err = m.loadGatewayClasses()
+	
+	// Additional resources
+	case NetworkPoliciesResource:
+		resources, err = m.loadNetworkPolicies()
+	case HorizontalPodAutoscalersResource:
+		resources, err = m.loadHorizontalPodAutoscalers()
+	case VerticalPodAutoscalersResource:
+		resources, err = m.loadVerticalPodAutoscalers()
+	}
+	
+	if err == nil {
+		m.rules.apply(resources, m.now())
 	}
+	return resources, err
 }
 
 // Update handles messages and state transitions - required by Bubble Tea
@@ -531,6 +1102,21 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		} else {
 			m.kubernetesContexts = msg.contexts
 			m.errorMessage = ""
+			
+			// Go straight to the context from --context or the config on startup
+			target := m.startContext
+			if target == "" && m.config != nil {
+				target = m.config.DefaultContext
+			}
+			m.startContext = ""
+			if target != "" && m.currentView == KubernetesContextView && len(m.viewStack) == 0 {
+				for i, ctx := range m.kubernetesContexts {
+					if ctx == target {
+						m.cursor = i
+						return m.handleSelection()
+					}
+				}
+			}
 		}
 		return m, nil
 		
@@ -558,39 +1144,91 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.selectedKubeContext = msg.contextName
 			m.errorMessage = ""
 			
+			// Completion data belongs to the previous context
+			m.shortNames = nil
+			m.completionNamespaces = nil
+			if namespace := m.settings().DefaultNamespace; namespace != "" {
+				m.selectedNamespace = configNamespace(namespace)
+			}
+			m.autoRefresh = m.settings().AutoRefresh && !m.refreshDisabled
+			m.applyTheme() // The new context may configure its own theme
+			
 			// Move to next view
 			m.viewStack = append(m.viewStack, m.currentView)
 			m.currentView = ClusterOrNamespaceView
 			m.cursor = 0
+			if m.startCommand != "" {
+				return m.runStartCommand()
+			}
 		}
 		return m, nil
 		
 	case namespacesLoadedMsg:
 		m.loading = false
 		if msg.err != nil {
-			if strings.Contains(msg.err.Error(), "no such host") || strings.Contains(msg.err.Error(), "connection refused") {
+			if isConnectionError(msg.err) {
 				m.errorMessage = "❌ Cannot connect to cluster. Please check your kubeconfig and ensure you have a real Kubernetes cluster running."
 			} else {
 				m.errorMessage = fmt.Sprintf("Error loading namespaces: %v", msg.err)
 			}
 		} else {
 			m.namespaces = msg.namespaces
+			m.noticeMessage = msg.notice
 			m.errorMessage = ""
+			if m.currentView == NamespaceView {
+				m.cursor = m.defaultNamespaceCursor()
+			}
 		}
 		return m, nil
 		
+	case paletteDataLoadedMsg:
+		m.shortNames = msg.shortNames
+		m.completionNamespaces = msg.namespaces
+		return m, nil
+		
+	case permissionsLoadedMsg:
+		m.permissionCache[msg.key] = msg.permissions
+		return m, nil
+		
 	case resourcesLoadedMsg:
 		m.loading = false
 		if msg.err != nil {
-			if strings.Contains(msg.err.Error(), "no such host") || strings.Contains(msg.err.Error(), "connection refused") {
+			if isConnectionError(msg.err) {
 				m.errorMessage = "❌ Cannot connect to cluster. Please check your kubeconfig and ensure you have a real Kubernetes cluster running."
 			} else {
 				m.errorMessage = fmt.Sprintf("Error loading resources: %v", msg.err)
 			}
 		} else {
+			// Keep the cursor on the same resource across reloads and re-sorts
+			selected := ""
+			if m.cursor < len(m.resources) {
+				selected = resourceKey(m.resources[m.cursor])
+			}
 			m.resources = msg.resources
+			if m.changes != nil {
+				m.changes.track(m.changeListKey(), msg.resources)
+			}
+			m = m.sortResources().moveCursorTo(selected)
 			m.errorMessage = ""
 			m.lastUpdate = time.Now()
+			if m.focusResource != "" {
+				// Drill-down from the dashboard: select the offending object
+				for i, res := range m.resources {
+					if res.Name == m.focusResource {
+						m.cursor = i
+					}
+				}
+				m.focusResource = ""
+			}
+			if m.cursor >= len(m.resources) {
+				m.cursor = max(len(m.resources)-1, 0)
+			}
+			m = m.clampCursorToFilter()
+			if m.selectedResource == PodsResource || m.selectedResource == NodesResource {
+				m.metricsAvailable = msg.metricsErr == nil
+				m.metricsStatus = describeMetricsError(msg.metricsErr)
+				m.recordMetricHistory(msg.resources)
+			}
 		}
 		return m, nil
 		
@@ -600,8 +1238,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.errorMessage = fmt.Sprintf("Error loading logs: %v", msg.err)
 		} else {
 			m.logEntries = msg.logs
+			m.logContainer = msg.container
+			m.logContainers = msg.containers
 			m.errorMessage = ""
 			m.lastUpdate = time.Now()
+			if m.recorder != nil && msg.pod != "" {
+				m.recorder.logs[msg.pod] = recordedLog{Text: msg.raw, LoadedAt: time.Now()}
+			}
 		}
 		return m, nil
 		
@@ -615,76 +1258,260 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.lastUpdate = time.Now()
 		}
 		return m, nil
+
+	case execFinishedMsg:
+		if msg.err != nil {
+			m.errorMessage = fmt.Sprintf("Exec failed: %v", msg.err)
+		}
+		return m, nil
 		
-	case refreshMsg:
-		// Auto-refresh current view if enabled
-		if m.autoRefresh {
-			switch m.currentView {
-			case DetailView:
-				return m, m.loadResources()
-			case LogView:
-				return m, m.loadLogs()
-			case EventView:
-				return m, m.loadEventsCmd()
-			}
+	case cronJobActionMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.errorMessage = msg.err.Error()
+			m.statusMessage = ""
+			return m, nil
 		}
-		return m, tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
-			return refreshMsg{}
-		})
-	}
-	
-	return m, nil
-}
+		m.errorMessage = ""
+		m.statusMessage = msg.message
+		// Reload so the new Job / suspend state shows up immediately
+		m.loading = true
+		return m, m.loadResources()
 
-// handleKeyPress processes keyboard input and navigation
-func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	
-	case "ctrl+c", "q":
-		return m, tea.Quit
-		
-	case "up", "k":
-		if m.currentView == LogView {
-			if m.logScrollOffset > 0 {
-				m.logScrollOffset--
+	case ownershipTreeLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.errorMessage = fmt.Sprintf("Error building ownership tree: %v", msg.err)
+		} else {
+			// Keep the cursor in place on refresh, start at the origin otherwise
+			if len(m.ownershipRows) == 0 {
+				m.cursor = msg.origin
 			}
-		} else if m.currentView == EventView {
-			if m.eventScrollOffset > 0 {
-				m.eventScrollOffset--
+			m.ownershipRows = msg.rows
+			m.ownershipOrigin = msg.origin
+			m.ownershipSkipped = msg.skipped
+			m.errorMessage = ""
+			m.lastUpdate = time.Now()
+			if m.cursor >= len(m.ownershipRows) {
+				m.cursor = len(m.ownershipRows) - 1
 			}
-		} else if m.cursor > 0 {
-			m.cursor--
 		}
-		
-	case "down", "j":
-		if m.currentView == LogView {
-			maxScroll := len(m.logEntries) - (m.height - 10)
-			if maxScroll < 0 {
-				maxScroll = 0
+		return m, nil
+
+	case comparisonLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.errorMessage = fmt.Sprintf("Error comparing: %v", msg.err)
+		} else {
+			m.comparison = msg.comparison
+			m.errorMessage = ""
+			m.lastUpdate = time.Now()
+			if m.currentView == CompareView && m.cursor >= len(msg.comparison.Rows) {
+				m.cursor = max(len(msg.comparison.Rows)-1, 0)
 			}
-			if m.logScrollOffset < maxScroll {
-				m.logScrollOffset++
+			if m.currentView == CompareDiffView {
+				row, ok := m.diffedRow()
+				if !ok {
+					// The object no longer differs
+					m.cursor = 0
+					return m.navigateBack()
+				}
+				m.cursor = min(m.cursor, max(len(row.Fields)-1, 0))
 			}
-		} else if m.currentView == EventView {
-			maxScroll := len(m.eventEntries) - (m.height - 10)
-			if maxScroll < 0 {
-				maxScroll = 0
+			m = m.clampCursorToFilter()
+		}
+		return m, nil
+
+	case clusterHealthLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.errorMessage = fmt.Sprintf("Error scanning cluster health: %v", msg.err)
+		} else {
+			m.clusterHealth = msg.health
+			m.errorMessage = ""
+			m.lastUpdate = time.Now()
+			if m.cursor >= len(msg.health.Items) {
+				m.cursor = len(msg.health.Items) - 1
 			}
-			if m.eventScrollOffset < maxScroll {
-				m.eventScrollOffset++
+			if m.cursor < 0 {
+				m.cursor = 0
 			}
-		} else {
-			switch m.currentView {
-			case KubernetesContextView:
+			m = m.clampCursorToFilter()
+		}
+		return m, nil
+		
+	case trafficPathLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.errorMessage = fmt.Sprintf("Error tracing traffic path: %v", msg.err)
+		} else {
+			m.trafficRows = msg.rows
+			m.errorMessage = ""
+			m.lastUpdate = time.Now()
+			if m.cursor >= len(m.trafficRows) {
+				m.cursor = len(m.trafficRows) - 1
+			}
+			if m.cursor < 0 {
+				m.cursor = 0
+			}
+		}
+		return m, nil
+
+	case cronJobHistoryLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.errorMessage = fmt.Sprintf("Error loading run history: %v", msg.err)
+		} else {
+			m.historyEntries = msg.entries
+			m.errorMessage = ""
+			m.lastUpdate = time.Now()
+			if m.cursor >= len(m.historyEntries) && len(m.historyEntries) > 0 {
+				m.cursor = len(m.historyEntries) - 1
+			}
+			m = m.clampCursorToFilter()
+		}
+		return m, nil
+
+	case notificationTickMsg:
+		if m.clientset == nil {
+			return m, m.scheduleNotificationPoll()
+		}
+		return m, m.pollWatched()
+		
+	case notificationPollMsg:
+		return m, tea.Batch(m.processNotificationPoll(msg), m.scheduleNotificationPoll())
+		
+	case snapshotCapturedMsg:
+		return m.handleSnapshotCaptured(msg)
+		
+	case snapshotTickMsg:
+		if msg.run != m.recorder.run || !m.recorder.active {
+			return m, nil
+		}
+		return m, m.nextRecordedFrame()
+		
+	case notificationSentMsg:
+		for _, n := range m.notifications.history {
+			if n.ID == msg.id {
+				n.Status = "sent"
+				if msg.err != nil {
+					n.Status, n.Error = "failed", msg.err.Error()
+				}
+			}
+		}
+		return m, nil
+		
+	case refreshMsg:
+		// Auto-refresh current view if enabled; the tick always re-arms so the
+		// refresh cycle (and the metric history fed by it) keeps running
+		if m.autoRefresh {
+			switch m.currentView {
+			case DetailView, MultiFrameView:
+				return m, tea.Batch(m.loadResources(), m.scheduleRefresh())
+			case LogView:
+				return m, tea.Batch(m.loadLogs(), m.scheduleRefresh())
+			case EventView:
+				return m, tea.Batch(m.loadEventsCmd(), m.scheduleRefresh())
+			case CronJobHistoryView:
+				return m, tea.Batch(m.loadCronJobHistory(), m.scheduleRefresh())
+			case OwnershipTreeView:
+				if m.ownershipTarget != nil {
+					return m, tea.Batch(m.loadOwnershipTree(*m.ownershipTarget), m.scheduleRefresh())
+				}
+			case TrafficPathView:
+				if m.trafficTarget != nil {
+					return m, tea.Batch(m.loadTrafficPath(*m.trafficTarget), m.scheduleRefresh())
+				}
+			case DashboardView:
+				// A cluster-wide scan is expensive, so it runs less often than the tick
+				if !m.loading && time.Since(m.lastUpdate) >= dashboardRefreshInterval {
+					return m, tea.Batch(m.scanClusterHealth(), m.scheduleRefresh())
+				}
+			}
+		}
+		return m, m.scheduleRefresh()
+	}
+	
+	return m, nil
+}
+
+// handleKeyPress processes keyboard input and navigation
+func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// An active text input takes all keys
+	if m.inputMode != "" {
+		return m.handleInputKey(msg)
+	}
+	if msg.String() == "ctrl+c" {
+		return m, tea.Quit
+	}
+	if m.showHelp {
+		// Any key closes the help overlay
+		m.showHelp = false
+		return m, nil
+	}
+	
+	action := m.keyAction(msg.String())
+	switch action {
+	
+	case actionQuit:
+		return m, tea.Quit
+		
+	case actionClose:
+		// q closes the current view; only the top-level view quits
+		if len(m.viewStack) == 0 {
+			return m, tea.Quit
+		}
+		return m.navigateBack()
+		
+	case actionHelp:
+		m.showHelp = true
+		
+	case actionUp:
+		if m.filterActive() {
+			m.cursor = m.stepFiltered(-1)
+		} else if m.currentView == LogView {
+			if m.logScrollOffset > 0 {
+				m.logScrollOffset--
+			}
+		} else if m.currentView == EventView {
+			if m.eventScrollOffset > 0 {
+				m.eventScrollOffset--
+			}
+		} else if m.cursor > 0 {
+			m.cursor--
+		}
+		
+	case actionDown:
+		if m.filterActive() {
+			m.cursor = m.stepFiltered(1)
+		} else if m.currentView == LogView {
+			maxScroll := len(m.logEntries) - (m.height - 10)
+			if maxScroll < 0 {
+				maxScroll = 0
+			}
+			if m.logScrollOffset < maxScroll {
+				m.logScrollOffset++
+			}
+		} else if m.currentView == EventView {
+			maxScroll := len(m.eventEntries) - (m.height - 10)
+			if maxScroll < 0 {
+				maxScroll = 0
+			}
+			if m.eventScrollOffset < maxScroll {
+				m.eventScrollOffset++
+			}
+		} else {
+			switch m.currentView {
+			case KubernetesContextView:
 				if m.cursor < len(m.kubernetesContexts)-1 {
 					m.cursor++
 				}
 			case ClusterOrNamespaceView:
-				if m.cursor < 1 {
+				if m.cursor < 2 {
 					m.cursor++
 				}
 			case NamespaceView:
-				if m.cursor < len(m.namespaces)-1 {
+				if m.cursor < len(m.namespaces) {
 					m.cursor++
 				}
 			case ResourceView:
@@ -695,20 +1522,153 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				if m.cursor < len(m.resources)-1 {
 					m.cursor++
 				}
+			case CronJobHistoryView:
+				if m.cursor < len(m.historyEntries)-1 {
+					m.cursor++
+				}
+			case MultiFrameView:
+				if m.currentFrame == ResourceFrame && m.cursor < len(m.resources)-1 {
+					m.cursor++
+				}
+			case OwnershipTreeView:
+				if m.cursor < len(m.ownershipRows)-1 {
+					m.cursor++
+				}
+			case TrafficPathView:
+				if m.cursor < len(m.trafficRows)-1 {
+					m.cursor++
+				}
+			case DashboardView:
+				if m.clusterHealth != nil && m.cursor < len(m.clusterHealth.Items)-1 {
+					m.cursor++
+				}
+			case NotificationsView:
+				if m.notifications != nil && m.cursor < len(m.notifications.history)-1 {
+					m.cursor++
+				}
+			case ChangelogView:
+				if m.cursor < len(m.changelog())-1 {
+					m.cursor++
+				}
+			case CompareView:
+				if m.comparison != nil && m.cursor < len(m.comparison.Rows)-1 {
+					m.cursor++
+				}
+			case CompareDiffView:
+				if row, ok := m.diffedRow(); ok && m.cursor < len(row.Fields)-1 {
+					m.cursor++
+				}
 			}
 		}
 		
-	case "enter", " ":
-		return m.handleSelection()
+	case actionSelect:
+		return m.selectFiltered()
 		
-	case "esc", "backspace":
+	case actionBack:
+		// Esc first clears an applied filter, then goes back
+		if m.filterActive() {
+			return m.clearFilter(), nil
+		}
 		return m.navigateBack()
 		
-	case "l":
+	case actionTheme:
+		// Cycle through the available themes
+		return m.switchTheme(""), nil
+		
+	case actionConfig:
+		// Show the effective configuration
+		if m.currentView != ConfigView {
+			return m.openConfigView()
+		}
+		
+	case actionNotifications:
+		// Show the notification history
+		if m.currentView != NotificationsView {
+			return m.openNotificationsView()
+		}
+		
+	case actionChangelog:
+		// Show what changed in the current list between refreshes
+		return m.openChangelogView()
+		
+	case actionReplayPrev:
+		// Step back through the recorded points in time
+		return m.seekReplay(m.replayFrame - 1)
+		
+	case actionReplayNext:
+		return m.seekReplay(m.replayFrame + 1)
+		
+	case actionCommand:
+		// Open the command palette
+		m.inputMode = inputCommand
+		m.inputBuffer = ""
+		m.inputError = ""
+		m.historyPos = -1
+		m.completionBase = ""
+		if m.shortNames == nil {
+			return m, m.loadPaletteData()
+		}
+		
+	case actionSortPrev, actionSortNext, actionSortReverse:
+		// Sort the resource table: previous/next pick the column, reverse flips the order
+		if m.currentView == DetailView || (m.currentView == MultiFrameView && m.currentFrame == ResourceFrame) {
+			selected := ""
+			if m.cursor < len(m.resources) {
+				selected = resourceKey(m.resources[m.cursor])
+			}
+			switch action {
+			case actionSortPrev:
+				m = m.cycleSortColumn(-1)
+			case actionSortNext:
+				m = m.cycleSortColumn(1)
+			case actionSortReverse:
+				order := m.sortOrders[m.selectedResource]
+				if order.Column == "" {
+					order.Column = "NAME"
+				}
+				order.Desc = !order.Desc
+				m.sortOrders[m.selectedResource] = order
+				m = m.sortResources()
+			}
+			m = m.moveCursorTo(selected)
+			if m.loading {
+				return m, m.loadResources()
+			}
+		}
+		
+	case actionFilter:
+		// Open the incremental filter for the current list
+		if m.supportsFilter() {
+			query := ""
+			if m.filterActive() {
+				query = m.filterQuery
+			}
+			m.filterView = m.currentView
+			m.filterQuery = query
+			m.inputMode = inputFilter
+			m.inputBuffer = query
+			m.inputError = ""
+		}
+		
+	case actionLogs:
+		// Show logs for a pod picked from a CronJob's run history
+		if m.currentView == CronJobHistoryView {
+			return m.openHistoryLogs()
+		}
+		if m.currentView == OwnershipTreeView {
+			return m.openOwnershipLogs()
+		}
+		if m.currentView == TrafficPathView {
+			return m.openTrafficLogs()
+		}
 		// Show logs for selected resource (if supported)
 		if m.currentView == DetailView && len(m.resources) > 0 && m.cursor < len(m.resources) {
 			selectedResource := &m.resources[m.cursor]
 			if selectedResource.ResourceType.GetResourceInfo().SupportsLogs {
+				if access := m.actionAccess("logs"); !access.Allowed {
+					m.errorMessage = fmt.Sprintf("Cannot view logs: %s", access.Reason)
+					return m, nil
+				}
 				m.selectedK8sResource = selectedResource
 				m.viewStack = append(m.viewStack, m.currentView)
 				m.currentView = MultiFrameView
@@ -721,11 +1681,50 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 		}
 		
-	case "e":
+	case actionEvents:
+		// Show events for the object under the cursor on the dashboard
+		if m.currentView == DashboardView {
+			return m.openDashboardEvents()
+		}
+		// Show events for the object on the selected traffic path line
+		if m.currentView == TrafficPathView && m.cursor < len(m.trafficRows) && m.trafficRows[m.cursor].Resource != nil {
+			entry := *m.trafficRows[m.cursor].Resource
+			m.selectedK8sResource = &entry
+			m.viewStack = append(m.viewStack, m.currentView)
+			m.currentView = EventView
+			m.eventScrollOffset = 0
+			m.loading = true
+			return m, m.loadEventsCmd()
+		}
+		// Show events for an object in the ownership tree
+		if m.currentView == OwnershipTreeView && m.cursor < len(m.ownershipRows) {
+			entry := m.ownershipRows[m.cursor].Resource
+			m.selectedK8sResource = &entry
+			m.viewStack = append(m.viewStack, m.currentView)
+			m.currentView = EventView
+			m.eventScrollOffset = 0
+			m.loading = true
+			return m, m.loadEventsCmd()
+		}
+		// Show events for a Job or pod in a CronJob's run history
+		if m.currentView == CronJobHistoryView && m.cursor < len(m.historyEntries) {
+			entry := m.historyEntries[m.cursor]
+			m.selectedK8sResource = &entry
+			m.viewStack = append(m.viewStack, m.currentView)
+			m.currentView = EventView
+			m.cursor = 0
+			m.eventScrollOffset = 0
+			m.loading = true
+			return m, m.loadEventsCmd()
+		}
 		// Show events for selected resource (if supported)
 		if m.currentView == DetailView && len(m.resources) > 0 && m.cursor < len(m.resources) {
 			selectedResource := &m.resources[m.cursor]
 			if selectedResource.ResourceType.GetResourceInfo().SupportsEvents {
+				if access := m.actionAccess("events"); !access.Allowed {
+					m.errorMessage = fmt.Sprintf("Cannot view events: %s", access.Reason)
+					return m, nil
+				}
 				m.selectedK8sResource = selectedResource
 				m.viewStack = append(m.viewStack, m.currentView)
 				m.currentView = EventView
@@ -736,12 +1735,19 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 		}
 		
-	case "r":
+	case actionRefresh:
 		// Manual refresh
 		switch m.currentView {
 		case NamespaceView:
 			m.loading = true
 			return m, m.loadNamespaces()
+		case ResourceView:
+			// Re-check permissions, e.g. after a role binding was added
+			namespace := ""
+			if m.selectedScope == NamespaceScoped {
+				namespace = m.selectedNamespace
+			}
+			return m, m.checkPermissions(namespace, m.resourceTypes)
 		case DetailView:
 			m.loading = true
 			return m, m.loadResources()
@@ -751,23 +1757,140 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		case EventView:
 			m.loading = true
 			return m, m.loadEventsCmd()
+		case CronJobHistoryView:
+			m.loading = true
+			return m, m.loadCronJobHistory()
+		case OwnershipTreeView:
+			if m.ownershipTarget != nil {
+				m.loading = true
+				return m, m.loadOwnershipTree(*m.ownershipTarget)
+			}
+		case TrafficPathView:
+			if m.trafficTarget != nil {
+				m.loading = true
+				return m, m.loadTrafficPath(*m.trafficTarget)
+			}
+		case DashboardView:
+			m.loading = true
+			return m, m.scanClusterHealth()
+		case CompareView, CompareDiffView:
+			if m.comparison != nil {
+				m.loading = true
+				return m, m.loadComparison(m.comparison.Left, m.comparison.Right, m.comparison.Kinds)
+			}
+		}
+		
+	case actionTrigger:
+		// Trigger the selected CronJob now by creating a Job from its template
+		if cj := m.selectedCronJob(); cj != nil {
+			if access := m.actionAccess("trigger"); !access.Allowed {
+				m.errorMessage = fmt.Sprintf("Cannot trigger CronJob: %s", access.Reason)
+				return m, nil
+			}
+			m.loading = true
+			m.statusMessage = ""
+			return m, m.triggerCronJob(cj.Namespace, cj.Name)
+		}
+		
+	case actionSuspend:
+		// Suspend or resume the selected CronJob
+		if cj := m.selectedCronJob(); cj != nil {
+			if access := m.actionAccess("suspend"); !access.Allowed {
+				m.errorMessage = fmt.Sprintf("Cannot suspend/resume CronJob: %s", access.Reason)
+				return m, nil
+			}
+			m.loading = true
+			m.statusMessage = ""
+			return m, m.setCronJobSuspend(cj.Namespace, cj.Name, cj.Status != "Suspended")
+		}
+		
+	case actionHistory:
+		// Show run history (Jobs and their pods) for the selected CronJob
+		if cj := m.selectedCronJob(); cj != nil {
+			selected := *cj
+			m.historyCronJob = &selected
+			m.historyEntries = nil
+			m.viewStack = append(m.viewStack, m.currentView)
+			m.currentView = CronJobHistoryView
+			m.cursor = 0
+			m.loading = true
+			return m, m.loadCronJobHistory()
+		}
+		
+	case actionExec:
+		// Open a shell in the selected pod, in the pod's own namespace
+		if m.currentView == DetailView && m.cursor < len(m.resources) && m.resources[m.cursor].ResourceType == PodsResource {
+			if access := m.actionAccess("exec"); !access.Allowed {
+				m.errorMessage = fmt.Sprintf("Cannot exec into pod: %s", access.Reason)
+				return m, nil
+			}
+			return m, m.execIntoPod(m.resources[m.cursor])
+		}
+		
+	case actionLabelSelector, actionFieldSelector:
+		// Edit the label or field selector of the current resource type
+		if m.currentView == DetailView || (m.currentView == MultiFrameView && m.currentFrame == ResourceFrame) {
+			selector := m.selectors[m.selectedResource]
+			m.inputMode = inputLabelSelector
+			m.inputBuffer = selector.Label
+			if action == actionFieldSelector {
+				m.inputMode = inputFieldSelector
+				m.inputBuffer = selector.Field
+			}
+			m.inputError = ""
+		}
+		
+	case actionOwnership:
+		// Open the ownership tree for the selected resource
+		if m.currentView == DetailView && m.cursor < len(m.resources) {
+			selected := m.resources[m.cursor]
+			if !supportsOwnershipTree(selected.ResourceType) {
+				m.errorMessage = fmt.Sprintf("Ownership tree is not available for %s", selected.ResourceType)
+				return m, nil
+			}
+			m.ownershipTarget = &selected
+			m.ownershipRows = nil
+			m.viewStack = append(m.viewStack, m.currentView)
+			m.currentView = OwnershipTreeView
+			m.cursor = 0
+			m.loading = true
+			return m, m.loadOwnershipTree(selected)
+		}
+		// Inside the tree, jump between the top-level controller and the origin resource
+		if m.currentView == OwnershipTreeView && len(m.ownershipRows) > 0 {
+			if m.cursor == 0 {
+				m.cursor = m.ownershipOrigin
+			} else {
+				m.cursor = 0
+			}
+		}
+		
+	case actionTraffic:
+		// Trace the traffic path of the selected Service, Ingress or Route
+		if m.currentView == DetailView && m.cursor < len(m.resources) {
+			selected := m.resources[m.cursor]
+			if !supportsTrafficPath(selected.ResourceType) {
+				m.errorMessage = fmt.Sprintf("Traffic path is not available for %s", selected.ResourceType)
+				return m, nil
+			}
+			m.trafficTarget = &selected
+			m.trafficRows = nil
+			m.viewStack = append(m.viewStack, m.currentView)
+			m.currentView = TrafficPathView
+			m.cursor = 0
+			m.loading = true
+			return m, m.loadTrafficPath(selected)
 		}
 		
-	case "a":
+	case actionAutoRefresh:
 		// Toggle auto-refresh
 		m.autoRefresh = !m.autoRefresh
-		if m.autoRefresh {
-			return m, tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
-				return refreshMsg{}
-			})
-		} else {
-			if m.refreshTicker != nil {
-				m.refreshTicker.Stop()
-				m.refreshTicker = nil
-			}
+		if !m.autoRefresh && m.refreshTicker != nil {
+			m.refreshTicker.Stop()
+			m.refreshTicker = nil
 		}
 		
-	case "m":
+	case actionMultiFrame:
 		// Toggle multi-frame mode when viewing resources
 		if m.currentView == DetailView {
 			m.viewStack = append(m.viewStack, m.currentView)
@@ -778,7 +1901,22 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.frameHeight = m.height - 10
 		}
 		
-	case "tab":
+	case actionContainer:
+		// Show the logs of the pod's next container
+		if m.currentView == LogView && len(m.logContainers) > 1 {
+			next := 0
+			for i, name := range m.logContainers {
+				if name == m.logContainer {
+					next = (i + 1) % len(m.logContainers)
+				}
+			}
+			m.logContainer = m.logContainers[next]
+			m.logScrollOffset = 0
+			m.loading = true
+			return m, m.loadLogs()
+		}
+		
+	case actionNextFrame:
 		// Switch between frames in multi-frame mode
 		if m.currentView == MultiFrameView {
 			switch m.currentFrame {
@@ -787,6 +1925,8 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			case LogFrame:
 				m.currentFrame = EventFrame
 			case EventFrame:
+				m.currentFrame = MetricsFrame
+			case MetricsFrame:
 				m.currentFrame = ResourceFrame
 			}
 			m.cursor = 0
@@ -829,17 +1969,20 @@ func (m Model) handleSelection() (tea.Model, tea.Cmd) {
 	case ClusterOrNamespaceView:
 		m.viewStack = append(m.viewStack, m.currentView)
 		if m.cursor == 0 {
+			// Cluster health dashboard across all namespaces
+			m.currentView = DashboardView
+			m.clusterHealth = nil
+			m.loading = true
+			return m, m.scanClusterHealth()
+		} else if m.cursor == 1 {
 			// Cluster-scoped resources
 			m.selectedScope = ClusterScoped
 			m.currentView = ResourceView
-			// Cluster-scoped resources
-			m.resourceTypes = []ResourceType{NodesResource, PersistentVolumesResource, StorageClassesResource, ClusterRolesResource}
-			// Add OpenShift Projects if available
-			if m.isOpenShift {
-				m.resourceTypes = append(m.resourceTypes, ProjectsResource)
+			m.resourceTypes = m.clusterResourceTypes()
+			m.cursor = 0
+			if m.permissions() == nil {
+				return m, m.checkPermissions("", m.resourceTypes)
 			}
-			// Add Gateway API cluster resources
-			m.resourceTypes = append(m.resourceTypes, GatewayClassesResource)
 		} else {
 			// Namespace-scoped resources
 			m.selectedScope = NamespaceScoped
@@ -850,32 +1993,28 @@ func (m Model) handleSelection() (tea.Model, tea.Cmd) {
 		m.cursor = 0
 		
 	case NamespaceView:
-		if len(m.namespaces) > 0 && m.cursor < len(m.namespaces) {
-			m.selectedNamespace = m.namespaces[m.cursor]
+		// Row 0 is "All namespaces", followed by the namespaces themselves
+		if m.cursor <= len(m.namespaces) {
+			m.selectedNamespace = metav1.NamespaceAll
+			if m.cursor > 0 {
+				m.selectedNamespace = m.namespaces[m.cursor-1]
+			}
 			m.viewStack = append(m.viewStack, m.currentView)
 			m.currentView = ResourceView
 			m.cursor = 0
 			
-			// Initialize namespace-scoped resource types including Events
-			m.resourceTypes = []ResourceType{
-				PodsResource, ServicesResource, DeploymentsResource,
-				ConfigMapsResource, SecretsResource, IngressResource,
-				PersistentVolumeClaimsResource, ReplicaSetsResource,
-				DaemonSetsResource, StatefulSetsResource,
-				JobsResource, CronJobsResource, EventsResource,
-			}
-			// Add OpenShift-specific resources if available
-			if m.isOpenShift {
-				m.resourceTypes = append(m.resourceTypes, RoutesResource, DeploymentConfigsResource, BuildConfigsResource, BuildsResource, ImageStreamsResource)
+			m.resourceTypes = m.namespacedResourceTypes()
+			if m.permissions() == nil {
+				return m, m.checkPermissions(m.selectedNamespace, m.resourceTypes)
 			}
-			// Add Gateway API resources if available
-			m.resourceTypes = append(m.resourceTypes, GatewaysResource, HTTPRoutesResource)
-			// Add additional common resources
-			m.resourceTypes = append(m.resourceTypes, NetworkPoliciesResource, HorizontalPodAutoscalersResource)
 		}
 		
 	case ResourceView:
 		if len(m.resourceTypes) > 0 && m.cursor < len(m.resourceTypes) {
+			if access := m.resourceAccess(m.resourceTypes[m.cursor]); !access.Allowed {
+				m.errorMessage = fmt.Sprintf("Cannot list %s: %s", m.resourceTypes[m.cursor], access.Reason)
+				return m, nil
+			}
 			m.selectedResource = m.resourceTypes[m.cursor]
 			m.viewStack = append(m.viewStack, m.currentView)
 			m.currentView = DetailView
@@ -884,11 +2023,103 @@ func (m Model) handleSelection() (tea.Model, tea.Cmd) {
 			
 			return m, m.loadResources()
 		}
+		
+	case CronJobHistoryView:
+		return m.openHistoryLogs()
+		
+	case OwnershipTreeView:
+		return m.openOwnershipLogs()
+		
+	case TrafficPathView:
+		return m.openTrafficLogs()
+		
+	case DashboardView:
+		return m.openDashboardItem()
+
+	case CompareView:
+		return m.openDriftDiff()
 	}
 	
 	return m, nil
 }
 
+// openOwnershipLogs shows logs for the pod under the cursor in OwnershipTreeView
+func (m Model) openOwnershipLogs() (tea.Model, tea.Cmd) {
+	if m.cursor >= len(m.ownershipRows) {
+		return m, nil
+	}
+	entry := m.ownershipRows[m.cursor].Resource
+	if entry.ResourceType != PodsResource {
+		return m, nil
+	}
+	m.selectedK8sResource = &entry
+	m.viewStack = append(m.viewStack, m.currentView)
+	m.currentView = LogView
+	m.logScrollOffset = 0
+	m.logEntries = nil
+	m.loading = true
+	return m, m.loadLogs()
+}
+
+// openTrafficLogs shows logs for the pod on the selected line in TrafficPathView
+func (m Model) openTrafficLogs() (tea.Model, tea.Cmd) {
+	if m.cursor >= len(m.trafficRows) {
+		return m, nil
+	}
+	entry := m.trafficRows[m.cursor].Resource
+	if entry == nil || entry.ResourceType != PodsResource {
+		return m, nil
+	}
+	pod := *entry
+	m.selectedK8sResource = &pod
+	m.viewStack = append(m.viewStack, m.currentView)
+	m.currentView = LogView
+	m.logScrollOffset = 0
+	m.logEntries = nil
+	m.loading = true
+	return m, m.loadLogs()
+}
+
+// execIntoPod suspends the UI and runs an interactive shell in the pod via kubectl
+func (m Model) execIntoPod(pod K8sResource) tea.Cmd {
+	args := []string{"exec", "-it", "-n", pod.Namespace, pod.Name, "--", "sh", "-c", "command -v bash >/dev/null && exec bash || exec sh"}
+	if m.selectedKubeContext != "" {
+		args = append([]string{"--context", m.selectedKubeContext}, args...)
+	}
+	return tea.ExecProcess(exec.Command("kubectl", args...), func(err error) tea.Msg {
+		return execFinishedMsg{err: err}
+	})
+}
+
+// selectedCronJob returns the CronJob under the cursor in DetailView, if any
+func (m Model) selectedCronJob() *K8sResource {
+	if m.currentView != DetailView || m.selectedResource != CronJobsResource {
+		return nil
+	}
+	if m.cursor >= len(m.resources) {
+		return nil
+	}
+	return &m.resources[m.cursor]
+}
+
+// openHistoryLogs shows logs for the pod under the cursor in CronJobHistoryView
+func (m Model) openHistoryLogs() (tea.Model, tea.Cmd) {
+	if m.cursor >= len(m.historyEntries) {
+		return m, nil
+	}
+	entry := m.historyEntries[m.cursor]
+	if entry.ResourceType != PodsResource {
+		return m, nil
+	}
+	m.selectedK8sResource = &entry
+	m.viewStack = append(m.viewStack, m.currentView)
+	m.currentView = LogView
+	m.logScrollOffset = 0
+	m.logEntries = nil
+	m.loading = true
+	return m, m.loadLogs()
+}
+
 // navigateBack handles back navigation
 func (m Model) navigateBack() (tea.Model, tea.Cmd) {
 	if len(m.viewStack) > 0 {
@@ -900,6 +2131,9 @@ func (m Model) navigateBack() (tea.Model, tea.Cmd) {
 		m.logScrollOffset = 0
 		m.eventScrollOffset = 0
 		m.errorMessage = ""
+		m.statusMessage = ""
+		m.noticeMessage = ""
+		m.filterQuery = ""
 	}
 	return m, nil
 }
@@ -917,10 +2151,7 @@ func (m Model) View() string {
 		Width(m.width).
 		Align(lipgloss.Center)
 		
-	selectedStyle := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(colors.Background).
-		Background(colors.Primary).
+	selectedStyle := colors.selection(colors.Primary).
 		Padding(0, 1)
 		
 	normalStyle := lipgloss.NewStyle().
@@ -978,13 +2209,33 @@ func (m Model) View() string {
 		content.WriteString(errorStyle.Render("❌ " + m.errorMessage) + "\n\n")
 	}
 	
+	// Result of the last user action
+	if m.statusMessage != "" {
+		content.WriteString(successStyle.Render("✅ " + m.statusMessage) + "\n\n")
+	}
+	
+	// Informational notice
+	if m.noticeMessage != "" {
+		content.WriteString(infoStyle.Render("ℹ️  " + m.noticeMessage) + "\n\n")
+	}
+	
+	// Inline text input
+	if m.inputMode != "" {
+		content.WriteString(m.renderInput() + "\n\n")
+	}
+	
 	// Loading indicator
 	if m.loading {
 		content.WriteString(infoStyle.Render("⏳ Loading...") + "\n\n")
 	}
 	
-	// Main content based on current view
-	switch m.currentView {
+	// Main content based on current view; the help overlay takes its place
+	view := m.currentView
+	if m.showHelp {
+		view = -1
+		content.WriteString(m.renderKeyHelp())
+	}
+	switch view {
 	case KubernetesContextView:
 		content.WriteString(successStyle.Render("🔧 Select Context:") + "\n\n")
 		
@@ -993,7 +2244,11 @@ func (m Model) View() string {
 		currentContextBytes, _ := cmd.Output()
 		currentContext := strings.TrimSpace(string(currentContextBytes))
 		
+		matches := m.filterMatches()
 		for i, ctx := range m.kubernetesContexts {
+			if matches != nil && !matches[i] {
+				continue
+			}
 			prefix := "  "
 			style := normalStyle
 			if i == m.cursor {
@@ -1008,41 +2263,49 @@ func (m Model) View() string {
 				// Keep the same color instead of changing to green
 			}
 			
-			content.WriteString(prefix + style.Render(contextDisplay) + "\n")
+			content.WriteString(prefix + m.highlightMatches(contextDisplay, style) + "\n")
 		}
 		
 	case ClusterOrNamespaceView:
 		content.WriteString(successStyle.Render("🌐 Select Resource Scope:") + "\n\n")
-		options := []string{"🏢 Cluster Resources", "📁 Namespace Resources"}
-		for i, option := range options {
+		matches := m.filterMatches()
+		for i, option := range scopeOptions {
+			if matches != nil && !matches[i] {
+				continue
+			}
 			prefix := "  "
 			style := normalStyle
 			if i == m.cursor {
 				prefix = "▶ "
 				style = selectedStyle
 			}
-			content.WriteString(prefix + style.Render(option) + "\n")
+			content.WriteString(prefix + m.highlightMatches(option, style) + "\n")
 		}
 		
 	case NamespaceView:
 		content.WriteString(successStyle.Render("📁 Select Namespace:") + "\n\n")
-		for i, ns := range m.namespaces {
+		matches := m.filterMatches()
+		for i, ns := range append([]string{allNamespacesLabel}, m.namespaces...) {
+			if matches != nil && !matches[i] {
+				continue
+			}
 			prefix := "  "
 			style := normalStyle
 			if i == m.cursor {
 				prefix = "▶ "
 				style = selectedStyle
 			}
-			content.WriteString(prefix + style.Render(ns) + "\n")
+			content.WriteString(prefix + m.highlightMatches(ns, style) + "\n")
 		}
 		
 	case ResourceView:
-		scopeStr := "cluster"
-		if m.selectedScope == NamespaceScoped {
-			scopeStr = fmt.Sprintf("namespace '%s'", m.selectedNamespace)
-		}
+		scopeStr := m.scopeLabel()
 		content.WriteString(successStyle.Render(fmt.Sprintf("📦 Resources in %s:", scopeStr)) + "\n\n")
+		matches := m.filterMatches()
 		for i, rt := range m.resourceTypes {
+			if matches != nil && !matches[i] {
+				continue
+			}
 			prefix := "  "
 			style := normalStyle
 			if i == m.cursor {
@@ -1050,7 +2313,17 @@ func (m Model) View() string {
 				style = selectedStyle
 			}
 			info := rt.GetResourceInfo()
-			content.WriteString(prefix + style.Render(fmt.Sprintf("%s %s", info.Icon, info.Name)) + "\n")
+			access := m.resourceAccess(rt)
+			if !access.Allowed {
+				// Forbidden types stay visible but greyed out, with the missing permission
+				if i != m.cursor {
+					style = lipgloss.NewStyle().Foreground(colors.Muted)
+				}
+				content.WriteString(prefix + m.highlightMatches(fmt.Sprintf("%s %s 🚫", info.Icon, info.Name), style) +
+					lipgloss.NewStyle().Foreground(colors.Muted).Italic(true).Render("  "+access.Reason) + "\n")
+				continue
+			}
+			content.WriteString(prefix + m.highlightMatches(fmt.Sprintf("%s %s", info.Icon, info.Name), style) + "\n")
 		}
 		
 	case DetailView:
@@ -1064,6 +2337,33 @@ func (m Model) View() string {
 		
 	case MultiFrameView:
 		content.WriteString(m.renderMultiFrameView())
+		
+	case CronJobHistoryView:
+		content.WriteString(m.renderCronJobHistory())
+		
+	case OwnershipTreeView:
+		content.WriteString(m.renderOwnershipTree())
+		
+	case TrafficPathView:
+		content.WriteString(m.renderTrafficPath())
+		
+	case DashboardView:
+		content.WriteString(m.renderDashboard())
+		
+	case ConfigView:
+		content.WriteString(m.renderConfig())
+		
+	case NotificationsView:
+		content.WriteString(m.renderNotifications())
+		
+	case ChangelogView:
+		content.WriteString(m.renderChangelog())
+		
+	case CompareView:
+		content.WriteString(m.renderComparison())
+		
+	case CompareDiffView:
+		content.WriteString(m.renderDriftDiff())
 	}
 	
 	// Help section with feature options and commands - using darker dividers
@@ -1095,1029 +2395,10695 @@ func (m Model) View() string {
 	return content.String()
 }
 
-// buildHeader creates the application header with context information
-func (m Model) buildHeader() string {
-	title := "🚀 k8sGo - Kubernetes Resource Monitor v2.0"
-	
-	var context []string
+// scopeOptions are the rows of ClusterOrNamespaceView, in cursor order
+var scopeOptions = []string{"🩺 Cluster Health Dashboard", "🏢 Cluster Resources", "📁 Namespace Resources"}
+
+// allNamespacesLabel is the NamespaceView entry that lists resources across the cluster
+const allNamespacesLabel = "🌐 All namespaces"
+
+// scopeLabel describes where the current resource list comes from
+func (m Model) scopeLabel() string {
 	if m.selectedScope == ClusterScoped {
-		context = append(context, "Scope: Cluster")
-	} else if m.selectedNamespace != "" {
-		context = append(context, fmt.Sprintf("Namespace: %s", m.selectedNamespace))
-	}
-	
-	if m.selectedResource.String() != "Unknown" {
-		info := m.selectedResource.GetResourceInfo()
-		context = append(context, fmt.Sprintf("Resource: %s %s", info.Icon, info.Name))
-	}
-	
-	if m.autoRefresh {
-		context = append(context, "Auto-refresh: ON")
+		return "cluster"
 	}
-	
-	// Create the full header text (lipgloss will handle centering and width)
-	if len(context) > 0 {
-		return title + " | " + strings.Join(context, " | ")
+	if m.selectedNamespace == metav1.NamespaceAll {
+		return "all namespaces"
 	}
-	return title
+	return fmt.Sprintf("namespace '%s'", m.selectedNamespace)
 }
 
-// renderResourceDetails creates the detailed resource view with enhanced error display
-func (m Model) renderResourceDetails() string {
-	if len(m.resources) == 0 {
-		scopeStr := "cluster"
-		if m.selectedScope == NamespaceScoped {
-			scopeStr = fmt.Sprintf("namespace '%s'", m.selectedNamespace)
+// ResourceSelector holds the label and field selectors applied to one resource type
+type ResourceSelector struct {
+	Label string // e.g. app=checkout,tier!=cache
+	Field string // e.g. status.phase=Running
+}
+
+// Inline text input modes
+const (
+	inputLabelSelector = "label"
+	inputFieldSelector = "field"
+	inputFilter        = "filter"
+	inputCommand       = "command"
+)
+
+// listOptions returns the list options for a resource type, carrying its session selectors
+func (m Model) listOptions(rt ResourceType) metav1.ListOptions {
+	selector := m.selectors[rt]
+	return metav1.ListOptions{LabelSelector: selector.Label, FieldSelector: selector.Field}
+}
+
+// validateInput parses the input buffer for the active input mode
+func (m Model) validateInput() error {
+	switch m.inputMode {
+	case inputLabelSelector:
+		if _, err := labels.Parse(m.inputBuffer); err != nil {
+			return fmt.Errorf("invalid label selector: %v", err)
 		}
-		return fmt.Sprintf("No %s found in %s", 
-			strings.ToLower(m.selectedResource.String()), scopeStr)
-	}
-	
-	var content strings.Builder
-	scopeStr := "cluster"
-	if m.selectedScope == NamespaceScoped {
-		scopeStr = fmt.Sprintf("namespace '%s'", m.selectedNamespace)
-	}
-	
-	info := m.selectedResource.GetResourceInfo()
-	
-	// Count resources with errors/warnings for summary
-	errorCount := 0
-	warningCount := 0
-	for _, resource := range m.resources {
-		if len(resource.Errors) > 0 {
-			errorCount++
+	case inputFieldSelector:
+		if _, err := fields.ParseSelector(m.inputBuffer); err != nil {
+			return fmt.Errorf("invalid field selector: %v", err)
 		}
-		if len(resource.Warnings) > 0 {
-			warningCount++
+	case inputCommand:
+		_, err := m.parseCommand(m.inputBuffer)
+		return err
+	case inputFilter:
+		for _, match := range m.filterMatches() {
+			if match {
+				return nil
+			}
+		}
+		if m.filterActive() {
+			return fmt.Errorf("no matches for '%s'", m.filterQuery)
 		}
 	}
-	
-	// Header with error/warning summary
-	headerText := fmt.Sprintf("📋 %s %s in %s (%d items)", 
-		info.Icon, m.selectedResource.String(), scopeStr, len(m.resources))
-	
-	if errorCount > 0 || warningCount > 0 {
-		headerText += fmt.Sprintf(" - ")
-		if errorCount > 0 {
-			headerText += lipgloss.NewStyle().Foreground(colors.Error).Render(fmt.Sprintf("%d errors", errorCount))
+	return nil
+}
+
+// handleInputKey edits the active text input; enter applies it and esc cancels
+func (m Model) handleInputKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEsc:
+		if m.inputMode == inputFilter {
+			return m.clearFilter(), nil
 		}
-		if errorCount > 0 && warningCount > 0 {
-			headerText += ", "
+		m.inputMode = ""
+		m.inputBuffer = ""
+		m.inputError = ""
+		return m, nil
+	case tea.KeyTab:
+		if m.inputMode == inputCommand {
+			m = m.cycleCompletion()
+			m.inputError = ""
 		}
-		if warningCount > 0 {
-			headerText += lipgloss.NewStyle().Foreground(colors.Warning).Render(fmt.Sprintf("%d warnings", warningCount))
+		return m, nil
+	case tea.KeyUp, tea.KeyDown:
+		// Browse the command history
+		if m.inputMode == inputCommand {
+			if msg.Type == tea.KeyUp {
+				m = m.recallCommand(-1)
+			} else {
+				m = m.recallCommand(1)
+			}
+			m.completionBase = ""
+			m.inputError = ""
+			return m, nil
+		}
+		// Move between matches while typing a filter
+		if m.inputMode == inputFilter {
+			if msg.Type == tea.KeyUp {
+				m.cursor = m.stepFiltered(-1)
+			} else {
+				m.cursor = m.stepFiltered(1)
+			}
+		}
+		return m, nil
+	case tea.KeyEnter:
+		if err := m.validateInput(); err != nil {
+			m.inputError = err.Error()
+			return m, nil
+		}
+		return m.applyInput()
+	case tea.KeyBackspace:
+		if runes := []rune(m.inputBuffer); len(runes) > 0 {
+			m.inputBuffer = string(runes[:len(runes)-1])
 		}
+	case tea.KeyCtrlU:
+		m.inputBuffer = ""
+	case tea.KeySpace:
+		m.inputBuffer += " "
+	case tea.KeyRunes:
+		m.inputBuffer += string(msg.Runes)
+	default:
+		return m, nil
 	}
 	
-	successStyle := lipgloss.NewStyle().Foreground(colors.Success).Bold(true)
-	normalStyle := lipgloss.NewStyle().Foreground(colors.Text)
-	selectedStyle := lipgloss.NewStyle().Bold(true).Foreground(colors.Background).Background(colors.Secondary).Padding(0, 1)
-	
-	content.WriteString(successStyle.Render(headerText) + "\n\n")
+	// Filter incrementally, keeping the cursor on a match
+	if m.inputMode == inputFilter {
+		m.filterQuery = strings.TrimSpace(m.inputBuffer)
+		m = m.clampCursorToFilter()
+	}
 	
-	// Table header
-	var headerFormat, rowFormat string
-	if m.selectedScope == NamespaceScoped {
-		headerFormat = "%-30s %-20s %-15s %-10s"
-		rowFormat = "%-30s %-20s %-15s %-10s"
-		header := fmt.Sprintf(headerFormat, "NAME", "NAMESPACE", "STATUS", "AGE")
-		content.WriteString(normalStyle.Render(header) + "\n")
-	} else {
-		headerFormat = "%-30s %-15s %-10s"
-		rowFormat = "%-30s %-15s %-10s"
-		header := fmt.Sprintf(headerFormat, "NAME", "STATUS", "AGE")
-		content.WriteString(normalStyle.Render(header) + "\n")
+	// Commands are validated on enter only, partial words are no error
+	m.completionBase = ""
+	m.inputError = ""
+	if m.inputMode == inputCommand {
+		return m, nil
 	}
-	dividerWidth := m.width
-	if dividerWidth == 0 {
-		dividerWidth = 70 // fallback width
+	
+	// Validate while typing so parse errors show inline
+	if err := m.validateInput(); err != nil {
+		m.inputError = err.Error()
 	}
-	dividerStyle := lipgloss.NewStyle().Foreground(colors.Muted)
-	content.WriteString(dividerStyle.Render(strings.Repeat("─", dividerWidth)) + "\n")
+	return m, nil
+}
+
+// applyInput acts on a validated text input
+func (m Model) applyInput() (tea.Model, tea.Cmd) {
+	mode, value := m.inputMode, strings.TrimSpace(m.inputBuffer)
+	m.inputMode = ""
+	m.inputBuffer = ""
+	m.inputError = ""
 	
-	// Table rows with color-coded status
-	for i, resource := range m.resources {
-		var row string
-		if m.selectedScope == NamespaceScoped {
-			row = fmt.Sprintf(rowFormat, 
-				truncateString(resource.Name, 30),
-				truncateString(resource.Namespace, 20),
-				truncateString(resource.Status, 15),
-				resource.Age)
+	switch mode {
+	case inputLabelSelector, inputFieldSelector:
+		selector := m.selectors[m.selectedResource]
+		if mode == inputLabelSelector {
+			selector.Label = value
 		} else {
-			row = fmt.Sprintf(rowFormat, 
-				truncateString(resource.Name, 30),
-				truncateString(resource.Status, 15),
-				resource.Age)
+			selector.Field = value
 		}
-		
-		// Choose style based on resource health
-		var style lipgloss.Style
-		if i == m.cursor {
-			style = selectedStyle
-		} else if len(resource.Errors) > 0 {
-			style = lipgloss.NewStyle().Foreground(colors.Error)
-		} else if len(resource.Warnings) > 0 {
-			style = lipgloss.NewStyle().Foreground(colors.Warning)
+		if selector == (ResourceSelector{}) {
+			delete(m.selectors, m.selectedResource)
 		} else {
-			style = normalStyle
+			m.selectors[m.selectedResource] = selector
 		}
+		m.cursor = 0
+		m.loading = true
+		return m, m.loadResources()
 		
-		content.WriteString(style.Render(row) + "\n")
+	case inputCommand:
+		if len(m.commandHistory) == 0 || m.commandHistory[len(m.commandHistory)-1] != value {
+			m.commandHistory = append(m.commandHistory, value)
+		}
+		cmd, _ := m.parseCommand(value)
+		return m.runCommand(cmd)
 		
-		// Show errors and warnings for selected resource
-		if i == m.cursor {
-			if len(resource.Errors) > 0 {
-				for _, err := range resource.Errors {
-					content.WriteString(lipgloss.NewStyle().Foreground(colors.Error).Render("  ❌ " + err) + "\n")
-				}
-			}
-			if len(resource.Warnings) > 0 {
-				for _, warning := range resource.Warnings {
-					content.WriteString(lipgloss.NewStyle().Foreground(colors.Warning).Render("  ⚠️  " + warning) + "\n")
-				}
+	case inputFilter:
+		// Keep the filter and select the match under the cursor
+		m.filterQuery = value
+		if value == "" {
+			return m, nil
+		}
+		return m.selectFiltered()
+	}
+	return m, nil
+}
+
+// renderInput renders the active text input with its validation error or a usage hint
+func (m Model) renderInput() string {
+	promptStyle := lipgloss.NewStyle().Foreground(colors.Info).Bold(true)
+	inputStyle := lipgloss.NewStyle().Foreground(colors.Text)
+	hintStyle := lipgloss.NewStyle().Foreground(colors.Text).Faint(true).Italic(true)
+	
+	prompt, hint := "", ""
+	switch m.inputMode {
+	case inputLabelSelector:
+		prompt = fmt.Sprintf("🏷️  Label selector for %s: ", m.selectedResource)
+		hint = "e.g. app=checkout,tier!=cache,env in (prod,staging) · enter: apply (empty clears) · esc: cancel"
+	case inputFieldSelector:
+		prompt = fmt.Sprintf("🔎 Field selector for %s: ", m.selectedResource)
+		hint = "e.g. status.phase=Running,spec.nodeName=node-3 · enter: apply (empty clears) · esc: cancel"
+	case inputFilter:
+		count, total := 0, len(m.filterTexts())
+		for _, match := range m.filterMatches() {
+			if match {
+				count++
 			}
-			
-			// Show additional details
-			if len(resource.Details) > 0 {
-				content.WriteString(lipgloss.NewStyle().Foreground(colors.Info).Render("  📝 Details:") + "\n")
-				for key, value := range resource.Details {
-					content.WriteString(fmt.Sprintf("     %s: %s\n", key, value))
+		}
+		if !m.filterActive() {
+			count = total
+		}
+		prompt = fmt.Sprintf("🔍 Filter (%d/%d): ", count, total)
+		hint = "matches names and key details · ↑/↓: next match · enter: select · esc: clear"
+	case inputCommand:
+		prompt = ":"
+		hint = "e.g. pods kube-system · deploy · ctx prod-eu · ns payments · events · dash · tab: complete · ↑/↓: history · esc: cancel"
+		if strings.TrimSpace(m.inputBuffer) != "" {
+			if matches := m.completeCommand(m.inputBuffer); len(matches) > 0 {
+				if len(matches) > 10 {
+					matches = append(matches[:10], "…")
 				}
+				hint = strings.Join(matches, "  ")
 			}
 		}
 	}
 	
-	return content.String()
+	line := promptStyle.Render(prompt) + inputStyle.Render(m.inputBuffer+"█")
+	if m.inputError != "" {
+		return line + "\n" + lipgloss.NewStyle().Foreground(colors.Error).Render("❌ "+m.inputError)
+	}
+	return line + "\n" + hintStyle.Render(hint)
 }
 
-// buildFeaturesText shows available features and actions for current view
-func (m Model) buildFeaturesText() string {
-	var features []string
-	featureStyle := lipgloss.NewStyle().Foreground(colors.Info).Bold(true)
-	actionStyle := lipgloss.NewStyle().Foreground(colors.Success)
+// renderSelectorChips renders the selectors of the current resource type as header chips
+func (m Model) renderSelectorChips() string {
+	selector := m.selectors[m.selectedResource]
+	chipStyle := lipgloss.NewStyle().Foreground(colors.Background).Background(colors.Info).Padding(0, 1)
 	
+	var chips []string
+	if selector.Label != "" {
+		chips = append(chips, chipStyle.Render("🏷️  "+selector.Label))
+	}
+	if selector.Field != "" {
+		chips = append(chips, chipStyle.Render("🔎 "+selector.Field))
+	}
+	return strings.Join(chips, " ")
+}
+
+// supportsFilter reports whether the current view is a list that can be filtered with '/'
+func (m Model) supportsFilter() bool {
 	switch m.currentView {
-	case DetailView:
-		if len(m.resources) > 0 && m.cursor < len(m.resources) {
-			selectedResource := &m.resources[m.cursor]
-			info := selectedResource.ResourceType.GetResourceInfo()
-			
-			features = append(features, featureStyle.Render("Available Actions:"))
-			
-			if info.SupportsLogs {
-				features = append(features, actionStyle.Render("  📜 Press 'l' - View logs for selected resource"))
-			}
-			if info.SupportsEvents {
-				features = append(features, actionStyle.Render("  📢 Press 'e' - View events for selected resource"))
-			}
-			features = append(features, actionStyle.Render("  🔲 Press 'm' - Switch to multi-frame view"))
-			features = append(features, actionStyle.Render("  🔄 Press 'r' - Refresh resource list"))
-			features = append(features, actionStyle.Render("  ⚡ Press 'a' - Toggle auto-refresh"))
-		}
+	case KubernetesContextView, ClusterOrNamespaceView, NamespaceView, ResourceView,
+		DetailView, CronJobHistoryView, DashboardView, CompareView:
+		return true
 	case MultiFrameView:
-		features = append(features, featureStyle.Render("Multi-Frame Features:"))
-		features = append(features, actionStyle.Render("  🔄 Press 'tab' - Switch between Resource, Log, and Event frames"))
-		features = append(features, actionStyle.Render("  📦 Resource Frame - Navigate and select resources"))
-		features = append(features, actionStyle.Render("  📜 Log Frame - View real-time logs"))
-		features = append(features, actionStyle.Render("  📢 Event Frame - View Kubernetes events"))
-		features = append(features, actionStyle.Render("  🔄 Press 'r' - Refresh current frame"))
-	case KubernetesContextView:
-		features = append(features, featureStyle.Render("Context Selection:"))
-		features = append(features, actionStyle.Render("  🔧 Select cluster context to connect"))
-		features = append(features, actionStyle.Render("  ✅ Current active context is marked"))
-		features = append(features, actionStyle.Render("  🚀 Auto-switches kubectl context on selection"))
-	case ResourceView:
-		features = append(features, featureStyle.Render("Resource Types Available:"))
-		if m.isOpenShift {
-			features = append(features, actionStyle.Render("  🔗 OpenShift Routes, DeploymentConfigs, Projects"))
-		}
-		features = append(features, actionStyle.Render("  🚪 Gateway API resources (Gateways, HTTPRoutes)"))
-		features = append(features, actionStyle.Render("  🛡️ Network Policies, Autoscalers"))
-		features = append(features, actionStyle.Render("  🐳 Pods, Services, Deployments, and more"))
+		return m.currentFrame == ResourceFrame
 	}
-	
-	if len(features) == 0 {
-		return ""
+	return false
+}
+
+// filterActive reports whether a filter query applies to the current view
+func (m Model) filterActive() bool {
+	return m.filterQuery != "" && m.filterView == m.currentView && m.supportsFilter()
+}
+
+// resourceFilterTexts returns the searchable texts of a resource: name first, then key details
+func resourceFilterTexts(res K8sResource) []string {
+	texts := []string{res.Name, res.Namespace, res.Status}
+	keys := make([]string, 0, len(res.Details))
+	for key := range res.Details {
+		keys = append(keys, key)
 	}
-	
-	// Center the features text
-	var centeredFeatures []string
-	for _, feature := range features {
-		if m.width > 0 {
-			// Remove styles for length calculation
-			plainText := lipgloss.NewStyle().Render(feature)
-			padding := (m.width - len(plainText)) / 2
-			if padding > 0 {
-				feature = strings.Repeat(" ", padding) + feature
-			}
-		}
-		centeredFeatures = append(centeredFeatures, feature)
+	sort.Strings(keys)
+	for _, key := range keys {
+		texts = append(texts, fmt.Sprintf("%s: %s", key, res.Details[key]))
 	}
-	
-	return strings.Join(centeredFeatures, "\n")
+	return texts
 }
 
-// buildHelpText creates context-sensitive help information
-func (m Model) buildHelpText() string {
-	var help []string
-	
+// filterTexts returns the searchable texts of every row of the current list, indexed like the cursor
+func (m Model) filterTexts() [][]string {
+	var rows [][]string
 	switch m.currentView {
 	case KubernetesContextView:
-		help = []string{
-			"↑/k: up", "↓/j: down", "enter/space: select", "q: quit",
+		for _, ctx := range m.kubernetesContexts {
+			rows = append(rows, []string{ctx})
 		}
-	case LogView:
-		help = []string{
-			"↑/k: scroll up", "↓/j: scroll down", "esc: back", 
-			"r: refresh logs", "a: toggle auto-refresh", "q: quit",
+	case ClusterOrNamespaceView:
+		for _, option := range scopeOptions {
+			rows = append(rows, []string{option})
 		}
-	case EventView:
-		help = []string{
-			"↑/k: scroll up", "↓/j: scroll down", "esc: back", 
-			"r: refresh events", "a: toggle auto-refresh", "q: quit",
+	case NamespaceView:
+		for _, ns := range append([]string{allNamespacesLabel}, m.namespaces...) {
+			rows = append(rows, []string{ns})
 		}
-	case DetailView:
-		if len(m.resources) > 0 && m.cursor < len(m.resources) {
-			selectedResource := &m.resources[m.cursor]
-			info := selectedResource.ResourceType.GetResourceInfo()
-			helpItems := []string{"↑/k: up", "↓/j: down", "enter: select", "esc: back", "r: refresh", "a: toggle auto-refresh", "m: multi-frame", "q: quit"}
-			if info.SupportsLogs {
-				helpItems = append(helpItems[:3], append([]string{"l: view logs"}, helpItems[3:]...)...)
-			}
-			if info.SupportsEvents {
-				helpItems = append(helpItems[:4], append([]string{"e: view events"}, helpItems[4:]...)...)
-			}
-			help = helpItems
-		} else {
-			help = []string{
-				"↑/k: up", "↓/j: down", "enter: select", "esc: back",
-				"r: refresh", "a: toggle auto-refresh", "m: multi-frame", "q: quit",
+	case ResourceView:
+		for _, rt := range m.resourceTypes {
+			rows = append(rows, []string{rt.GetResourceInfo().Name, rt.String()})
+		}
+	case DetailView, MultiFrameView:
+		for _, res := range m.resources {
+			rows = append(rows, resourceFilterTexts(res))
+		}
+	case CronJobHistoryView:
+		for _, entry := range m.historyEntries {
+			rows = append(rows, resourceFilterTexts(entry))
+		}
+	case DashboardView:
+		if m.clusterHealth != nil {
+			for _, item := range m.clusterHealth.Items {
+				name := item.Resource.Name
+				if item.Resource.Namespace != "" {
+					name = item.Resource.Namespace + "/" + name
+				}
+				rows = append(rows, []string{name, kindLabel(item.Resource.ResourceType), item.Summary})
 			}
 		}
-	case MultiFrameView:
-		help = []string{
-			"↑/k: up", "↓/j: down", "tab: switch frame", "esc: back", 
-			"r: refresh", "a: toggle auto-refresh", "q: quit",
+	case CompareView:
+		if m.comparison != nil {
+			for _, row := range m.comparison.Rows {
+				rows = append(rows, append([]string{row.Name, kindLabel(row.Kind)}, row.Areas...))
+			}
 		}
-	default:
-		help = []string{
-			"↑/k: up", "↓/j: down", "enter/space: select", "esc: back",
-			"r: refresh", "a: toggle auto-refresh", "q: quit",
+	}
+	return rows
+}
+
+// filterMatches returns which rows of the current list match the filter, or nil when no filter applies
+func (m Model) filterMatches() []bool {
+	if !m.filterActive() {
+		return nil
+	}
+	rows := m.filterTexts()
+	matches := make([]bool, len(rows))
+	for i, texts := range rows {
+		for _, text := range texts {
+			if fuzzyMatch(m.filterQuery, text) != nil {
+				matches[i] = true
+				break
+			}
 		}
 	}
-	
-	return strings.Join(help, " | ")
+	return matches
 }
 
-// Placeholder functions for resource loading - implement based on your needs
-func (m Model) loadNodes() ([]K8sResource, error) {
-	nodes, err := m.clientset.CoreV1().Nodes().List(m.ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, err
+// fuzzyMatch returns the rune positions in text matching query case-insensitively, or nil.
+// A contiguous substring is preferred; otherwise the query runes must appear in order.
+func fuzzyMatch(query, text string) []int {
+	query = strings.ToLower(strings.ReplaceAll(query, " ", ""))
+	if query == "" {
+		return nil
+	}
+	runes := []rune(text)
+	for i, r := range runes {
+		runes[i] = unicode.ToLower(r)
 	}
+	needle := []rune(query)
 	
-	var resources []K8sResource
-	now := time.Now()
+	if idx := strings.Index(string(runes), query); idx >= 0 {
+		start := utf8.RuneCountInString(string(runes)[:idx])
+		positions := make([]int, len(needle))
+		for i := range needle {
+			positions[i] = start + i
+		}
+		return positions
+	}
 	
-	for _, node := range nodes.Items {
-		age := humanAge(now.Sub(node.CreationTimestamp.Time))
-		
-		status := "Ready"
-		var nodeErrors []string
-		var nodeWarnings []string
-		
-		// Check node conditions
-		for _, condition := range node.Status.Conditions {
-			if condition.Type == corev1.NodeReady {
-				if condition.Status != corev1.ConditionTrue {
-					status = "NotReady"
-					nodeErrors = append(nodeErrors, fmt.Sprintf("Node is not ready: %s", condition.Message))
-				}
-			} else if condition.Status == corev1.ConditionTrue {
-				switch condition.Type {
-				case corev1.NodeMemoryPressure:
-					nodeWarnings = append(nodeWarnings, "Memory pressure detected")
-				case corev1.NodeDiskPressure:
-					nodeWarnings = append(nodeWarnings, "Disk pressure detected")
-				case corev1.NodePIDPressure:
-					nodeWarnings = append(nodeWarnings, "PID pressure detected")
-				case corev1.NodeNetworkUnavailable:
-					nodeErrors = append(nodeErrors, "Network unavailable")
-				}
-			}
-		}
-		
-		// Get resource usage info
-		allocatable := node.Status.Allocatable
-		cpu := allocatable[corev1.ResourceCPU]
-		memory := allocatable[corev1.ResourceMemory]
-		
-		resource := K8sResource{
-			Name:         node.Name,
-			Namespace:    "", // Nodes are cluster-scoped
-			Status:       status,
-			Age:          age,
-			ResourceType: NodesResource,
-			Errors:       nodeErrors,
-			Warnings:     nodeWarnings,
-			Details: map[string]string{
-				"CPU":            cpu.String(),
-				"Memory":         memory.String(),
-				"OS":             node.Status.NodeInfo.OperatingSystem,
-				"Kernel":         node.Status.NodeInfo.KernelVersion,
-				"Container Runtime": node.Status.NodeInfo.ContainerRuntimeVersion,
-			},
+	var positions []int
+	for i, r := range runes {
+		if len(positions) < len(needle) && r == needle[len(positions)] {
+			positions = append(positions, i)
 		}
-		resources = append(resources, resource)
 	}
-	
-	return resources, nil
+	if len(positions) < len(needle) {
+		return nil
+	}
+	return positions
 }
 
-// Additional placeholder resource loading functions
-func (m Model) loadPods() ([]K8sResource, error) {
-	pods, err := m.clientset.CoreV1().Pods(m.selectedNamespace).List(m.ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, err
+// highlightMatches renders text in style with the runes matching the active filter emphasised
+func (m Model) highlightMatches(text string, style lipgloss.Style) string {
+	positions := map[int]bool{}
+	if m.filterActive() {
+		for _, pos := range fuzzyMatch(m.filterQuery, text) {
+			positions[pos] = true
+		}
+	}
+	if len(positions) == 0 {
+		return style.Render(text)
 	}
 	
-	var resources []K8sResource
-	now := time.Now()
-	
-	for _, pod := range pods.Items {
-		age := humanAge(now.Sub(pod.CreationTimestamp.Time))
-		
-		status := string(pod.Status.Phase)
-		var podErrors []string
-		var podWarnings []string
-		
-		// Check pod conditions and container statuses
-		for _, condition := range pod.Status.Conditions {
-			if condition.Status == corev1.ConditionFalse {
-				switch condition.Type {
-				case corev1.PodReady:
-					podErrors = append(podErrors, "Pod is not ready")
-				case corev1.PodScheduled:
-					if condition.Reason == "Unschedulable" {
-						podErrors = append(podErrors, "Pod cannot be scheduled")
-					}
-				}
-			}
-		}
-		
-		// Check container statuses
-		restartCount := int32(0)
-		for _, containerStatus := range pod.Status.ContainerStatuses {
-			restartCount += containerStatus.RestartCount
-			if containerStatus.State.Waiting != nil {
-				podWarnings = append(podWarnings, fmt.Sprintf("Container %s is waiting: %s", 
-					containerStatus.Name, containerStatus.State.Waiting.Reason))
-			}
-			if containerStatus.State.Terminated != nil && containerStatus.State.Terminated.ExitCode != 0 {
-				podErrors = append(podErrors, fmt.Sprintf("Container %s terminated with exit code %d", 
-					containerStatus.Name, containerStatus.State.Terminated.ExitCode))
-			}
-		}
-		
-		if restartCount > 0 {
-			podWarnings = append(podWarnings, fmt.Sprintf("Container(s) restarted %d times", restartCount))
-		}
-		
-		ready := 0
-		for _, containerStatus := range pod.Status.ContainerStatuses {
-			if containerStatus.Ready {
-				ready++
-			}
+	// Render runs of matched and unmatched runes separately, keeping the outer padding
+	inner := style.UnsetPadding()
+	match := inner.Underline(true).Bold(true)
+	if _, plain := style.GetBackground().(lipgloss.NoColor); plain {
+		match = match.Foreground(colors.Primary)
+	}
+	var out strings.Builder
+	out.WriteString(inner.Render(strings.Repeat(" ", style.GetPaddingLeft())))
+	runes := []rune(text)
+	for start := 0; start < len(runes); {
+		end := start
+		for end < len(runes) && positions[end] == positions[start] {
+			end++
 		}
-		
-		resource := K8sResource{
-			Name:         pod.Name,
-			Namespace:    pod.Namespace,
-			Status:       status,
-			Age:          age,
-			ResourceType: PodsResource,
-			Errors:       podErrors,
-			Warnings:     podWarnings,
-			Details: map[string]string{
-				"Ready":         fmt.Sprintf("%d/%d", ready, len(pod.Spec.Containers)),
-				"Restarts":      strconv.FormatInt(int64(restartCount), 10),
-				"Node":          pod.Spec.NodeName,
-				"Pod IP":        pod.Status.PodIP,
-			},
+		if positions[start] {
+			out.WriteString(match.Render(string(runes[start:end])))
+		} else {
+			out.WriteString(inner.Render(string(runes[start:end])))
 		}
-		resources = append(resources, resource)
+		start = end
 	}
-	
-	return resources, nil
+	out.WriteString(inner.Render(strings.Repeat(" ", style.GetPaddingRight())))
+	return out.String()
 }
 
-// Add more resource loading functions as needed...
-func (m Model) loadPersistentVolumes() ([]K8sResource, error) { return []K8sResource{}, nil }
-func (m Model) loadStorageClasses() ([]K8sResource, error) { return []K8sResource{}, nil }
-func (m Model) loadClusterRoles() ([]K8sResource, error) { return []K8sResource{}, nil }
-func (m Model) loadServices() ([]K8sResource, error) {
-	services, err := m.clientset.CoreV1().Services(m.selectedNamespace).List(m.ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, err
+// filterDetailHint returns the first key detail matching the filter when the row itself doesn't show a match
+func (m Model) filterDetailHint(res K8sResource) string {
+	if !m.filterActive() {
+		return ""
 	}
-	
-	var resources []K8sResource
-	now := time.Now()
-	
-	for _, service := range services.Items {
-		age := humanAge(now.Sub(service.CreationTimestamp.Time))
-		
-		status := "Active"
-		clusterIP := service.Spec.ClusterIP
-		if clusterIP == "" {
-			clusterIP = "None"
-		}
-		
-		var ports []string
-		for _, port := range service.Spec.Ports {
-			if port.NodePort != 0 {
-				ports = append(ports, fmt.Sprintf("%d:%d/%s", port.Port, port.NodePort, port.Protocol))
-			} else {
-				ports = append(ports, fmt.Sprintf("%d/%s", port.Port, port.Protocol))
-			}
+	texts := resourceFilterTexts(res)
+	for _, text := range texts[:3] {
+		if fuzzyMatch(m.filterQuery, text) != nil {
+			return ""
 		}
-		portsStr := strings.Join(ports, ",")
-		if portsStr == "" {
-			portsStr = "None"
+	}
+	for _, text := range texts[3:] {
+		if fuzzyMatch(m.filterQuery, text) != nil {
+			return "     ↳ " + m.highlightMatches(text, lipgloss.NewStyle().Foreground(colors.Info).Italic(true)) + "\n"
 		}
-		
-		resource := K8sResource{
-			Name:         service.Name,
-			Namespace:    service.Namespace,
-			Status:       status,
-			Age:          age,
-			ResourceType: ServicesResource,
-			Details: map[string]string{
-				"Type":       string(service.Spec.Type),
-				"Cluster-IP": clusterIP,
-				"Ports":      portsStr,
-				"Selector":   fmt.Sprintf("%v", service.Spec.Selector),
-			},
+	}
+	return ""
+}
+
+// stepFiltered returns the next matching row from the cursor in direction dir, or the cursor itself
+func (m Model) stepFiltered(dir int) int {
+	matches := m.filterMatches()
+	for i := m.cursor + dir; i >= 0 && i < len(matches); i += dir {
+		if matches[i] {
+			return i
 		}
-		resources = append(resources, resource)
 	}
-	
-	return resources, nil
+	return m.cursor
 }
-func (m Model) loadDeployments() ([]K8sResource, error) {
-	deployments, err := m.clientset.AppsV1().Deployments(m.selectedNamespace).List(m.ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, err
+
+// clampCursorToFilter moves the cursor onto the nearest matching row when the filter hides it
+func (m Model) clampCursorToFilter() Model {
+	matches := m.filterMatches()
+	if matches == nil || (m.cursor < len(matches) && matches[m.cursor]) {
+		return m
 	}
-	
-	var resources []K8sResource
-	now := time.Now()
-	
-	for _, deployment := range deployments.Items {
-		age := humanAge(now.Sub(deployment.CreationTimestamp.Time))
-		
-		status := "Available"
-		var deploymentErrors []string
-		var deploymentWarnings []string
-		
-		// Check deployment conditions
-		for _, condition := range deployment.Status.Conditions {
-			if condition.Type == "Available" && condition.Status != "True" {
-				status = "NotAvailable"
-				deploymentErrors = append(deploymentErrors, "Deployment not available")
-			}
-			if condition.Type == "Progressing" && condition.Status != "True" {
-				deploymentWarnings = append(deploymentWarnings, "Deployment not progressing")
-			}
-		}
-		
-		// Check replica status
-		ready := fmt.Sprintf("%d/%d", deployment.Status.ReadyReplicas, deployment.Status.Replicas)
-		if deployment.Status.ReadyReplicas != deployment.Status.Replicas {
-			status = "NotReady"
-			deploymentWarnings = append(deploymentWarnings, "Not all replicas are ready")
+	for i := m.cursor; i < len(matches); i++ {
+		if matches[i] {
+			m.cursor = i
+			return m
 		}
-		
-		resource := K8sResource{
-			Name:         deployment.Name,
-			Namespace:    deployment.Namespace,
-			Status:       status,
-			Age:          age,
-			ResourceType: DeploymentsResource,
-			Errors:       deploymentErrors,
-			Warnings:     deploymentWarnings,
-			Details: map[string]string{
-				"Ready":           ready,
-				"Up-to-date":      fmt.Sprintf("%d", deployment.Status.UpdatedReplicas),
-				"Available":       fmt.Sprintf("%d", deployment.Status.AvailableReplicas),
-				"Strategy":        string(deployment.Spec.Strategy.Type),
-			},
+	}
+	for i := min(m.cursor, len(matches)) - 1; i >= 0; i-- {
+		if matches[i] {
+			m.cursor = i
+			return m
 		}
-		resources = append(resources, resource)
 	}
-	
-	return resources, nil
+	return m
 }
-func (m Model) loadConfigMaps() ([]K8sResource, error) { return []K8sResource{}, nil }
-func (m Model) loadSecrets() ([]K8sResource, error) { return []K8sResource{}, nil }
-func (m Model) loadIngress() ([]K8sResource, error) { return []K8sResource{}, nil }
-func (m Model) loadPersistentVolumeClaims() ([]K8sResource, error) { return []K8sResource{}, nil }
-func (m Model) loadReplicaSets() ([]K8sResource, error) { return []K8sResource{}, nil }
-func (m Model) loadDaemonSets() ([]K8sResource, error) { return []K8sResource{}, nil }
-func (m Model) loadStatefulSets() ([]K8sResource, error) { return []K8sResource{}, nil }
-func (m Model) loadJobs() ([]K8sResource, error) { return []K8sResource{}, nil }
-func (m Model) loadCronJobs() ([]K8sResource, error) { return []K8sResource{}, nil }
-func (m Model) loadEventsResource() ([]K8sResource, error) { return []K8sResource{}, nil }
 
-// Additional OpenShift resource loading functions
-func (m Model) loadBuildConfigs() ([]K8sResource, error) { return []K8sResource{}, nil }
-func (m Model) loadBuilds() ([]K8sResource, error) { return []K8sResource{}, nil }
-func (m Model) loadImageStreams() ([]K8sResource, error) { return []K8sResource{}, nil }
+// clearFilter drops the filter query, e.g. when leaving the filtered view
+func (m Model) clearFilter() Model {
+	m.filterQuery = ""
+	if m.inputMode == inputFilter {
+		m.inputMode = ""
+		m.inputBuffer = ""
+		m.inputError = ""
+	}
+	return m
+}
 
-// Gateway API resource loading functions
-func (m Model) loadGateways() ([]K8sResource, error) { return []K8sResource{}, nil }
-func (m Model) loadHTTPRoutes() ([]K8sResource, error) { return []K8sResource{}, nil }
-func (m Model) loadGatewayClasses() ([]K8sResource, error) { return []K8sResource{}, nil }
+// selectFiltered selects the row under the cursor and drops the filter if the view changes
+func (m Model) selectFiltered() (tea.Model, tea.Cmd) {
+	if matches := m.filterMatches(); matches != nil && (m.cursor >= len(matches) || !matches[m.cursor]) {
+		// No row matches the filter, so the cursor is on a hidden row
+		return m, nil
+	}
+	next, cmd := m.handleSelection()
+	if nm, ok := next.(Model); ok && nm.currentView != m.currentView {
+		return nm.clearFilter(), cmd
+	}
+	return next, cmd
+}
 
-// Additional resource loading functions
-func (m Model) loadNetworkPolicies() ([]K8sResource, error) { return []K8sResource{}, nil }
-func (m Model) loadHorizontalPodAutoscalers() ([]K8sResource, error) { return []K8sResource{}, nil }
-func (m Model) loadVerticalPodAutoscalers() ([]K8sResource, error) { return []K8sResource{}, nil }
+// clusterResourceTypes returns the resource types listed for cluster scope
+func (m Model) clusterResourceTypes() []ResourceType {
+	types := []ResourceType{NodesResource, PersistentVolumesResource, StorageClassesResource, ClusterRolesResource}
+	// Add OpenShift Projects if available
+	if m.isOpenShift {
+		types = append(types, ProjectsResource)
+	}
+	// Add Gateway API cluster resources
+	return m.enabledResourceTypes(append(types, GatewayClassesResource))
+}
 
-// OpenShift-specific resource loading functions
-func (m Model) loadRoutes() ([]K8sResource, error) {
-	if m.routeClient == nil {
-		return nil, fmt.Errorf("OpenShift Route client not available")
+// namespacedResourceTypes returns the resource types listed for a namespace, including Events
+func (m Model) namespacedResourceTypes() []ResourceType {
+	types := []ResourceType{
+		PodsResource, ServicesResource, DeploymentsResource,
+		ConfigMapsResource, SecretsResource, IngressResource,
+		PersistentVolumeClaimsResource, ReplicaSetsResource,
+		DaemonSetsResource, StatefulSetsResource,
+		JobsResource, CronJobsResource, EventsResource,
 	}
-	
-	routes, err := m.routeClient.RouteV1().Routes(m.selectedNamespace).List(m.ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, err
+	// Add OpenShift-specific resources if available
+	if m.isOpenShift {
+		types = append(types, RoutesResource, DeploymentConfigsResource, BuildConfigsResource, BuildsResource, ImageStreamsResource)
 	}
+	// Add Gateway API resources if available
+	types = append(types, GatewaysResource, HTTPRoutesResource)
+	// Add additional common resources
+	return m.enabledResourceTypes(append(types, NetworkPoliciesResource, HorizontalPodAutoscalersResource))
+}
+
+// Palette verbs other than resource kinds
+var paletteVerbs = map[string]string{
+	"ctx":       "ctx",
+	"context":   "ctx",
+	"ns":        "ns",
+	"namespace": "ns",
+	"dash":      "dash",
+	"health":    "dash",
+	"config":    "config",
+	"compare":   "compare",
+	"drift":     "compare",
+	"notify":    "notifications",
+	"snapshot":  "snapshot",
+	"record":    "record",
+	"theme":     "theme",
+	"q":         "quit",
+	"quit":      "quit",
+}
+
+// builtinShortNames are kubectl short names used until discovery has answered
+var builtinShortNames = map[string]ResourceType{
+	"no": NodesResource, "pv": PersistentVolumesResource, "sc": StorageClassesResource,
+	"po": PodsResource, "svc": ServicesResource, "deploy": DeploymentsResource,
+	"cm": ConfigMapsResource, "ing": IngressResource, "pvc": PersistentVolumeClaimsResource,
+	"rs": ReplicaSetsResource, "ds": DaemonSetsResource, "sts": StatefulSetsResource,
+	"cj": CronJobsResource, "ev": EventsResource, "dc": DeploymentConfigsResource,
+	"bc": BuildConfigsResource, "is": ImageStreamsResource, "netpol": NetworkPoliciesResource,
+	"hpa": HorizontalPodAutoscalersResource,
+}
+
+// staticKindAliases maps the plural, singular and built-in short names of every kind
+func staticKindAliases() map[string]ResourceType {
+	aliases := map[string]ResourceType{}
+	for rt, kind := range resourceKinds {
+		_, resource := rt.apiResource()
+		aliases[resource] = rt
+		aliases[strings.ToLower(kind)] = rt
+	}
+	for name, rt := range builtinShortNames {
+		aliases[name] = rt
+	}
+	return aliases
+}
+
+// kindAliases maps every name the palette accepts for a kind, including short names from discovery
+func (m Model) kindAliases() map[string]ResourceType {
+	aliases := staticKindAliases()
+	for name, rt := range m.shortNames {
+		aliases[name] = rt
+	}
+	return aliases
+}
+
+// loadPaletteData fetches short names from discovery and namespace names for completion
+func (m Model) loadPaletteData() tea.Cmd {
+	return func() tea.Msg {
+		byResource := map[[2]string]ResourceType{}
+		for rt := range resourceKinds {
+			group, resource := rt.apiResource()
+			byResource[[2]string{group, resource}] = rt
+		}
 	
-	var resources []K8sResource
-	now := time.Now()
-	
-	for _, route := range routes.Items {
-		age := humanAge(now.Sub(route.CreationTimestamp.Time))
-		
-		status := "Active"
-		var routeErrors []string
-		var routeWarnings []string
-		
-		// Check route conditions
-		for _, condition := range route.Status.Ingress {
-			for _, cond := range condition.Conditions {
-				if cond.Type == routev1.RouteAdmitted && cond.Status != corev1.ConditionTrue {
-					status = "NotAdmitted"
-					routeErrors = append(routeErrors, fmt.Sprintf("Route not admitted: %s", cond.Message))
+		// Discovery may fail for single API groups; use whatever was returned
+		shortNames := map[string]ResourceType{}
+		lists, _ := m.clientset.Discovery().ServerPreferredResources()
+		for _, list := range lists {
+			gv, err := schema.ParseGroupVersion(list.GroupVersion)
+			if err != nil {
+				continue
+			}
+			for _, apiResource := range list.APIResources {
+				rt, ok := byResource[[2]string{gv.Group, apiResource.Name}]
+				if !ok {
+					continue
+				}
+				for _, short := range apiResource.ShortNames {
+					shortNames[short] = rt
 				}
 			}
 		}
-		
-		// Extract host
-		host := route.Spec.Host
-		if host == "" && len(route.Status.Ingress) > 0 {
-			host = route.Status.Ingress[0].Host
-		}
-		
-		resource := K8sResource{
-			Name:         route.Name,
-			Namespace:    route.Namespace,
-			Status:       status,
-			Age:          age,
-			ResourceType: RoutesResource,
-			Errors:       routeErrors,
-			Warnings:     routeWarnings,
-			Details: map[string]string{
-				"Host":    host,
-				"Path":    route.Spec.Path,
-				"Service": route.Spec.To.Name,
-				"Port":    fmt.Sprintf("%v", route.Spec.Port),
-			},
+	
+		var namespaces []string
+		if nsList, err := m.clientset.CoreV1().Namespaces().List(m.ctx, metav1.ListOptions{}); err == nil {
+			for _, ns := range nsList.Items {
+				namespaces = append(namespaces, ns.Name)
+			}
+		} else {
+			namespaces, _ = m.fallbackNamespaces()
 		}
-		resources = append(resources, resource)
-	}
+		sort.Strings(namespaces)
 	
-	return resources, nil
+		return paletteDataLoadedMsg{shortNames: shortNames, namespaces: namespaces}
+	}
 }
 
-func (m Model) loadDeploymentConfigs() ([]K8sResource, error) {
-	if m.openshiftAppsClient == nil {
-		return nil, fmt.Errorf("OpenShift Apps client not available")
+// paletteNamespaces returns the namespaces known for completion
+func (m Model) paletteNamespaces() []string {
+	if len(m.completionNamespaces) > 0 {
+		return m.completionNamespaces
 	}
-	
-	dcs, err := m.openshiftAppsClient.AppsV1().DeploymentConfigs(m.selectedNamespace).List(m.ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, err
+	return m.namespaces
+}
+
+// completeCommand returns the completions of the last word of a command line
+func (m Model) completeCommand(line string) []string {
+	words := strings.Fields(line)
+	if len(words) == 0 || strings.HasSuffix(line, " ") {
+		words = append(words, "")
 	}
+	prefix := words[len(words)-1]
 	
-	var resources []K8sResource
-	now := time.Now()
-	
-	for _, dc := range dcs.Items {
-		age := humanAge(now.Sub(dc.CreationTimestamp.Time))
-		
-		ready := fmt.Sprintf("%d/%d", dc.Status.ReadyReplicas, dc.Status.Replicas)
-		status := "Running"
-		var dcErrors []string
-		
-		if dc.Status.ReadyReplicas != dc.Status.Replicas {
-			status = "NotReady"
-			dcErrors = append(dcErrors, "Not all replicas are ready")
+	var candidates []string
+	switch {
+	case len(words) == 1:
+		for verb := range paletteVerbs {
+			candidates = append(candidates, verb)
 		}
-		
-		resource := K8sResource{
-			Name:         dc.Name,
-			Namespace:    dc.Namespace,
-			Status:       status,
-			Age:          age,
-			ResourceType: DeploymentConfigsResource,
-			Errors:       dcErrors,
-			Details: map[string]string{
-				"Ready":           ready,
-				"Available":       fmt.Sprintf("%d", dc.Status.AvailableReplicas),
-				"Latest Version":  fmt.Sprintf("%d", dc.Status.LatestVersion),
-				"Observed Gen":    fmt.Sprintf("%d", dc.Status.ObservedGeneration),
-			},
+		for alias := range m.kindAliases() {
+			candidates = append(candidates, alias)
+		}
+	case len(words) == 2 && paletteVerbs[words[0]] == "ctx":
+		candidates = m.kubernetesContexts
+	case len(words) == 2 && paletteVerbs[words[0]] == "theme":
+		candidates = m.config.themeNames()
+	case len(words) == 2 && paletteVerbs[words[0]] == "ns":
+		candidates = append([]string{"all"}, m.paletteNamespaces()...)
+	case len(words) == 2 && paletteVerbs[words[0]] == "record":
+		candidates = []string{"stop", "30s", "1m", "5m"}
+	case len(words) <= 4 && paletteVerbs[words[0]] == "compare":
+		// Sides: a context, CONTEXT/NAMESPACE or /NAMESPACE of the current context
+		context := m.selectedKubeContext
+		if i := strings.LastIndex(prefix, "/"); i >= 0 {
+			context = prefix[:i]
+		}
+		candidates = append(candidates, m.kubernetesContexts...)
+		for _, ns := range m.paletteNamespaces() {
+			candidates = append(candidates, "/"+ns)
+			if containsString(m.kubernetesContexts, context) {
+				candidates = append(candidates, context+"/"+ns)
+			}
+		}
+	case len(words) == 2:
+		if _, ok := m.kindAliases()[words[0]]; ok {
+			candidates = append([]string{"all"}, m.paletteNamespaces()...)
 		}
-		resources = append(resources, resource)
 	}
 	
-	return resources, nil
+	var matches []string
+	seen := map[string]bool{}
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, prefix) && !seen[candidate] {
+			seen[candidate] = true
+			matches = append(matches, candidate)
+		}
+	}
+	sort.Strings(matches)
+	return matches
 }
 
-func (m Model) loadProjects() ([]K8sResource, error) {
-	if m.projectClient == nil {
-		return nil, fmt.Errorf("OpenShift Project client not available")
+// cycleCompletion replaces the last word with the next completion; repeated Tabs cycle through them
+func (m Model) cycleCompletion() Model {
+	if m.completionBase == "" {
+		m.completionBase = m.inputBuffer
+		m.completionIndex = 0
+	} else {
+		m.completionIndex++
 	}
-	
-	projects, err := m.projectClient.ProjectV1().Projects().List(m.ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, err
+	matches := m.completeCommand(m.completionBase)
+	if len(matches) == 0 {
+		m.completionBase = ""
+		return m
 	}
 	
-	var resources []K8sResource
-	now := time.Now()
-	
-	for _, project := range projects.Items {
-		age := humanAge(now.Sub(project.CreationTimestamp.Time))
-		
-		status := string(project.Status.Phase)
-		var projectErrors []string
-		
-		if project.Status.Phase != corev1.NamespaceActive {
-			projectErrors = append(projectErrors, fmt.Sprintf("Project is in %s state", project.Status.Phase))
-		}
-		
-		resource := K8sResource{
-			Name:         project.Name,
-			Namespace:    "", // Projects are cluster-scoped
-			Status:       status,
-			Age:          age,
-			ResourceType: ProjectsResource,
-			Errors:       projectErrors,
-			Details: map[string]string{
-				"Display Name": project.Annotations["openshift.io/display-name"],
-				"Description":  project.Annotations["openshift.io/description"],
-				"Requester":    project.Annotations["openshift.io/requester"],
-			},
-		}
-		resources = append(resources, resource)
+	base := m.completionBase
+	if i := strings.LastIndex(base, " "); i >= 0 {
+		base = base[:i+1]
+	} else {
+		base = ""
 	}
-	
-	return resources, nil
+	m.inputBuffer = base + matches[m.completionIndex%len(matches)]
+	if len(matches) == 1 {
+		// A unique completion is final; continue with the next word
+		m.inputBuffer += " "
+		m.completionBase = ""
+	}
+	return m
 }
 
-// renderMultiFrameView creates the multi-frame layout with resources, logs, and events
-func (m Model) renderMultiFrameView() string {
-	if m.frameWidth == 0 {
-		m.frameWidth = m.width / 3
-		m.frameHeight = m.height - 10
+// recallCommand steps through the command history; dir is -1 for older and 1 for newer
+func (m Model) recallCommand(dir int) Model {
+	if len(m.commandHistory) == 0 {
+		return m
 	}
-	
-	var content strings.Builder
-	
-	// Frame headers
-	headerStyle := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(colors.Text).
-		Background(colors.Primary).
-		Padding(0, 1).
-		Width(m.frameWidth - 2)
-	
-	// Left frame: Resources
-	resourceHeaderText := "📦 Resources"
-	if m.currentFrame == ResourceFrame {
-		resourceHeaderText = "▶ " + resourceHeaderText
+	// historyPos -1 is the new, empty line after the newest entry
+	pos := m.historyPos
+	if pos < 0 {
+		pos = len(m.commandHistory)
 	}
-	resourceHeader := headerStyle.Render(resourceHeaderText)
-	
-	// Middle frame: Logs
-	logHeaderText := "📜 Logs"
-	if m.selectedK8sResource != nil {
-		logHeaderText = fmt.Sprintf("📜 Logs - %s", m.selectedK8sResource.Name)
+	pos += dir
+	if pos < 0 {
+		pos = 0
 	}
-	if m.currentFrame == LogFrame {
-		logHeaderText = "▶ " + logHeaderText
+	if pos >= len(m.commandHistory) {
+		m.historyPos = -1
+		m.inputBuffer = ""
+		return m
 	}
-	logHeader := headerStyle.Render(logHeaderText)
+	m.historyPos = pos
+	m.inputBuffer = m.commandHistory[pos]
+	return m
+}
+
+// paletteCommand is a parsed ':' command line
+type paletteCommand struct {
+	verb   string // ctx, ns, dash, config, notifications, theme, snapshot, record, compare, quit or kind
+	kind   ResourceType
+	arg    string // Context, namespace, theme, file or interval; "all" for all namespaces
+	hasArg bool
 	
-	// Right frame: Events
-	eventHeaderText := "📢 Events"
-	if m.selectedK8sResource != nil {
-		eventHeaderText = fmt.Sprintf("📢 Events - %s", m.selectedK8sResource.Name)
+	// compare: the two sides and the kinds compared
+	left, right compareSide
+	kinds       []ResourceType
+}
+
+// parseCommand parses and validates a ':' command line
+func (m Model) parseCommand(line string) (paletteCommand, error) {
+	words := strings.Fields(line)
+	if len(words) == 0 {
+		return paletteCommand{}, fmt.Errorf("empty command")
 	}
-	if m.currentFrame == EventFrame {
-		eventHeaderText = "▶ " + eventHeaderText
+	if paletteVerbs[words[0]] == "compare" {
+		cmd := paletteCommand{verb: "compare", hasArg: len(words) > 1}
+		var err error
+		cmd.left, cmd.right, cmd.kinds, err = m.parseCompareArgs(words[1:])
+		return cmd, err
+	}
+	if len(words) > 2 {
+		return paletteCommand{}, fmt.Errorf("too many arguments: %s", line)
+	}
+	cmd := paletteCommand{}
+	if len(words) == 2 {
+		cmd.arg, cmd.hasArg = words[1], true
+		if cmd.arg == "-A" {
+			cmd.arg = "all"
+		}
 	}
-	eventHeader := headerStyle.Render(eventHeaderText)
-	
-	// Render frame headers
-	content.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, resourceHeader, " ", logHeader, " ", eventHeader) + "\n")
-	
-	// Frame content styles
-	frameStyle := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(colors.Secondary).
-		Width(m.frameWidth - 4).
-		Height(m.frameHeight - 4).
-		Padding(1)
-	
-	activeFrameStyle := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(colors.Primary).
-		Width(m.frameWidth - 4).
-		Height(m.frameHeight - 4).
-		Padding(1)
 	
-	// Left frame: Resources content
-	var resourceContent string
-	if len(m.resources) == 0 {
-		resourceContent = "No resources found"
+	if verb, ok := paletteVerbs[words[0]]; ok {
+		cmd.verb = verb
+	} else if rt, ok := m.kindAliases()[strings.ToLower(words[0])]; ok {
+		cmd.verb, cmd.kind = "kind", rt
 	} else {
-		var resourceLines strings.Builder
-		for i, resource := range m.resources {
-			prefix := "  "
-			style := lipgloss.NewStyle().Foreground(colors.Text)
-			if i == m.cursor && m.currentFrame == ResourceFrame {
-				prefix = "▶ "
-				style = lipgloss.NewStyle().Bold(true).Foreground(colors.Background).Background(colors.Secondary).Padding(0, 1)
+		return cmd, fmt.Errorf("unknown command or kind '%s'", words[0])
+	}
+	
+	switch cmd.verb {
+	case "ctx":
+		if cmd.hasArg && !containsString(m.kubernetesContexts, cmd.arg) {
+			return cmd, fmt.Errorf("unknown context '%s'", cmd.arg)
+		}
+	case "theme":
+		if cmd.hasArg && !containsString(m.config.themeNames(), cmd.arg) {
+			return cmd, fmt.Errorf("unknown theme '%s' (available: %s)", cmd.arg, strings.Join(m.config.themeNames(), ", "))
+		}
+	case "snapshot", "record":
+		if m.replay != nil {
+			return cmd, fmt.Errorf("cannot record while browsing a snapshot or dump")
+		}
+		if m.clientset == nil {
+			return cmd, fmt.Errorf("not connected to a cluster")
+		}
+		if cmd.verb == "record" && !cmd.hasArg {
+			return cmd, fmt.Errorf("usage: :record <interval>|stop")
+		}
+		if cmd.verb == "record" && cmd.arg != "stop" {
+			if d, err := time.ParseDuration(cmd.arg); err != nil || d < minRecordInterval {
+				return cmd, fmt.Errorf("'%s' is not an interval of at least %s (e.g. 30s) or 'stop'", cmd.arg, minRecordInterval)
 			}
-			
-			// Resource status color
-			if len(resource.Errors) > 0 {
-				style = style.Foreground(colors.Error)
-			} else if len(resource.Warnings) > 0 {
-				style = style.Foreground(colors.Warning)
+		}
+	case "ns":
+		if cmd.hasArg && cmd.arg != "all" && len(m.paletteNamespaces()) > 0 && !containsString(m.paletteNamespaces(), cmd.arg) {
+			return cmd, fmt.Errorf("namespace '%s' not found", cmd.arg)
+		}
+	case "kind":
+		info := cmd.kind.GetResourceInfo()
+		available := m.namespacedResourceTypes()
+		if info.Scope == ClusterScoped {
+			available = m.clusterResourceTypes()
+			if cmd.hasArg {
+				return cmd, fmt.Errorf("%s are cluster-scoped and take no namespace", info.Name)
 			}
-			
-			resourceLines.WriteString(prefix + style.Render(fmt.Sprintf("%-25s %s", 
-				truncateString(resource.Name, 25), resource.Status)) + "\n")
 		}
-		resourceContent = resourceLines.String()
+		if !containsResourceType(available, cmd.kind) {
+			return cmd, fmt.Errorf("%s are not available on this cluster or disabled in the config", info.Name)
+		}
+		if cmd.hasArg && cmd.arg != "all" && len(m.paletteNamespaces()) > 0 && !containsString(m.paletteNamespaces(), cmd.arg) {
+			return cmd, fmt.Errorf("namespace '%s' not found", cmd.arg)
+		}
+	default:
+		if cmd.hasArg {
+			return cmd, fmt.Errorf("'%s' takes no argument", words[0])
+		}
 	}
-	
-	// Middle frame: Logs content
-	var logContent string
-	if m.selectedK8sResource == nil {
-		logContent = "Select a resource to view logs"
-	} else if len(m.logEntries) == 0 {
-		if m.loading {
-			logContent = "Loading logs..."
-		} else {
-			logContent = "No logs available for this resource"
+	return cmd, nil
+}
+
+// containsString reports whether list contains s
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
 		}
-	} else {
-		var logLines strings.Builder
-		startIdx := m.logScrollOffset
-		endIdx := min(startIdx + m.frameHeight - 6, len(m.logEntries))
-		
-		for i := startIdx; i < endIdx; i++ {
-			entry := m.logEntries[i]
-			timestamp := entry.Timestamp.Format("15:04:05")
-			logLines.WriteString(fmt.Sprintf("[%s] %s\n", timestamp, entry.Message))
+	}
+	return false
+}
+
+// containsResourceType reports whether list contains rt
+func containsResourceType(list []ResourceType, rt ResourceType) bool {
+	for _, item := range list {
+		if item == rt {
+			return true
 		}
-		logContent = logLines.String()
 	}
+	return false
+}
+
+// runCommand jumps to the view named by a validated ':' command
+func (m Model) runCommand(cmd paletteCommand) (tea.Model, tea.Cmd) {
+	// These commands stay in the current view
+	switch cmd.verb {
+	case "theme":
+		// Restyles the current view
+		return m.switchTheme(cmd.arg), nil
+	case "snapshot":
+		return m.takeSnapshot(cmd.arg)
+	case "record":
+		if cmd.arg == "stop" {
+			return m.stopRecording()
+		}
+		interval, _ := time.ParseDuration(cmd.arg) // Validated by parseCommand
+		return m.startRecording(interval)
+	}
+	m = m.clearFilter()
+	m.cursor = 0
+	m.errorMessage = ""
+	m.statusMessage = ""
+	m.noticeMessage = ""
 	
-	// Right frame: Events content
-	var eventContent string
-	if m.selectedK8sResource == nil {
-		eventContent = "Select a resource to view events"
-	} else if len(m.eventEntries) == 0 {
-		if m.loading {
-			eventContent = "Loading events..."
-		} else {
-			eventContent = "No events found for this resource"
+	switch cmd.verb {
+	case "quit":
+		return m, tea.Quit
+	
+	case "ctx":
+		m.viewStack = nil
+		m.currentView = KubernetesContextView
+		if !cmd.hasArg {
+			return m, nil
 		}
-	} else {
-		var eventLines strings.Builder
-		startIdx := m.eventScrollOffset
-		endIdx := min(startIdx + m.frameHeight - 6, len(m.eventEntries))
+		for i, ctx := range m.kubernetesContexts {
+			if ctx == cmd.arg {
+				m.cursor = i
+			}
+		}
+		return m.handleSelection()
+	
+	case "config":
+		return m.openConfigView()
+	
+	case "notifications":
+		return m.openNotificationsView()
 		
-		for i := startIdx; i < endIdx; i++ {
-			event := m.eventEntries[i]
-			timestamp := event.Timestamp.Format("15:04:05")
-			eventLines.WriteString(fmt.Sprintf("[%s] %s: %s\n", timestamp, event.Reason, event.Message))
+	case "compare":
+		return m.openComparison(cmd.left, cmd.right, cmd.kinds)
+		
+	case "dash":
+		m.viewStack = []ViewType{KubernetesContextView, ClusterOrNamespaceView}
+		m.currentView = DashboardView
+		m.clusterHealth = nil
+		m.loading = true
+		return m, m.scanClusterHealth()
+	
+	case "ns":
+		m.viewStack = []ViewType{KubernetesContextView, ClusterOrNamespaceView}
+		m.selectedScope = NamespaceScoped
+		if !cmd.hasArg {
+			m.currentView = NamespaceView
+			m.loading = true
+			return m, m.loadNamespaces()
 		}
-		eventContent = eventLines.String()
+		m.selectNamespace(cmd.arg)
+		m.viewStack = append(m.viewStack, NamespaceView)
+		m.currentView = ResourceView
+		m.resourceTypes = m.namespacedResourceTypes()
+		if m.permissions() == nil {
+			return m, m.checkPermissions(m.selectedNamespace, m.resourceTypes)
+		}
+		return m, nil
 	}
 	
-	// Apply frame styles
-	var leftFrame, middleFrame, rightFrame string
-	if m.currentFrame == ResourceFrame {
-		leftFrame = activeFrameStyle.Render(resourceContent)
-		middleFrame = frameStyle.Render(logContent)
-		rightFrame = frameStyle.Render(eventContent)
-	} else if m.currentFrame == LogFrame {
-		leftFrame = frameStyle.Render(resourceContent)
-		middleFrame = activeFrameStyle.Render(logContent)
-		rightFrame = frameStyle.Render(eventContent)
+	// Resource list of one kind, skipping the scope, namespace and type choices
+	m.viewStack = []ViewType{KubernetesContextView, ClusterOrNamespaceView}
+	if cmd.kind.GetResourceInfo().Scope == ClusterScoped {
+		m.selectedScope = ClusterScoped
+		m.resourceTypes = m.clusterResourceTypes()
 	} else {
-		leftFrame = frameStyle.Render(resourceContent)
-		middleFrame = frameStyle.Render(logContent)
-		rightFrame = activeFrameStyle.Render(eventContent)
+		m.selectedScope = NamespaceScoped
+		if cmd.hasArg {
+			m.selectNamespace(cmd.arg)
+		}
+		m.viewStack = append(m.viewStack, NamespaceView)
+		m.resourceTypes = m.namespacedResourceTypes()
 	}
+	m.viewStack = append(m.viewStack, ResourceView)
+	if access := m.resourceAccess(cmd.kind); !access.Allowed {
+		m.currentView = ResourceView
+		m.errorMessage = fmt.Sprintf("Cannot list %s: %s", cmd.kind, access.Reason)
+		m.viewStack = m.viewStack[:len(m.viewStack)-1]
+		return m, nil
+	}
+	m.selectedResource = cmd.kind
+	m.currentView = DetailView
+	m.loading = true
 	
-	// Join frames horizontally
-	content.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, leftFrame, " ", middleFrame, " ", rightFrame))
-	
-	return content.String()
+	cmds := []tea.Cmd{m.loadResources()}
+	if m.permissions() == nil {
+		namespace := ""
+		if m.selectedScope == NamespaceScoped {
+			namespace = m.selectedNamespace
+		}
+		cmds = append(cmds, m.checkPermissions(namespace, m.resourceTypes))
+	}
+	return m, tea.Batch(cmds...)
 }
 
-// Log and event loading functions
-func (m Model) loadLogs() tea.Cmd { 
-	return func() tea.Msg {
-		// Placeholder - implement actual log loading
-		var logs []LogEntry
-		
-		if m.selectedK8sResource != nil && m.selectedK8sResource.ResourceType == PodsResource {
-			// For pods, we could load actual logs
-			logs = []LogEntry{
-				{Timestamp: time.Now().Add(-5 * time.Minute), Message: "Pod started successfully", Container: "main", Level: "INFO"},
-				{Timestamp: time.Now().Add(-3 * time.Minute), Message: "Application initialized", Container: "main", Level: "INFO"},
-				{Timestamp: time.Now().Add(-1 * time.Minute), Message: "Ready to accept connections", Container: "main", Level: "INFO"},
-			}
+// openConfigView shows the effective configuration on top of the current view
+func (m Model) openConfigView() (tea.Model, tea.Cmd) {
+	m.viewStack = append(m.viewStack, m.currentView)
+	m.currentView = ConfigView
+	m.cursor = 0
+	return m, nil
+}
+
+// selectNamespace sets the namespace from a command argument; "all" lists across all namespaces
+func (m *Model) selectNamespace(arg string) {
+	m.selectedNamespace = arg
+	if arg == "all" {
+		m.selectedNamespace = metav1.NamespaceAll
+	}
+	if len(m.namespaces) == 0 {
+		// Let NamespaceView show something when navigating back
+		m.namespaces = m.completionNamespaces
+	}
+}
+
+// buildHeader creates the application header with context information
+func (m Model) buildHeader() string {
+	title := "🚀 k8sGo - Kubernetes Resource Monitor v2.0"
+	
+	var context []string
+	if m.selectedScope == ClusterScoped {
+		context = append(context, "Scope: Cluster")
+	} else if m.selectedNamespace != "" {
+		context = append(context, fmt.Sprintf("Namespace: %s", m.selectedNamespace))
+	} else if m.currentView != ClusterOrNamespaceView && m.currentView != NamespaceView && m.currentView != KubernetesContextView {
+		context = append(context, "Namespace: All")
+	}
+	
+	if m.selectedResource.String() != "Unknown" {
+		info := m.selectedResource.GetResourceInfo()
+		context = append(context, fmt.Sprintf("Resource: %s %s", info.Icon, info.Name))
+	}
+	
+	if m.autoRefresh {
+		context = append(context, "Auto-refresh: ON")
+	}
+	if m.readOnly {
+		context = append(context, "🔒 Read-only")
+	}
+	if status := m.replayStatus(); status != "" {
+		context = append(context, status)
+	}
+	if status := m.recordingStatus(); status != "" {
+		context = append(context, status)
+	}
+	
+	// Create the full header text (lipgloss will handle centering and width)
+	if len(context) > 0 {
+		return title + " | " + strings.Join(context, " | ")
+	}
+	return title
+}
+
+// renderResourceDetails creates the detailed resource view with enhanced error display
+func (m Model) renderResourceDetails() string {
+	chips := m.renderSelectorChips()
+	if len(m.resources) == 0 {
+		scopeStr := m.scopeLabel()
+		if chips != "" {
+			return fmt.Sprintf("No %s matching the selectors found in %s\n%s", 
+				strings.ToLower(m.selectedResource.String()), scopeStr, chips)
+		}
+		return fmt.Sprintf("No %s found in %s", 
+			strings.ToLower(m.selectedResource.String()), scopeStr)
+	}
+	
+	var content strings.Builder
+	scopeStr := m.scopeLabel()
+	
+	info := m.selectedResource.GetResourceInfo()
+	
+	// Count resources with errors/warnings for summary
+	errorCount := 0
+	warningCount := 0
+	for _, resource := range m.resources {
+		if len(resource.Errors) > 0 {
+			errorCount++
+		}
+		if len(resource.Warnings) > 0 {
+			warningCount++
+		}
+	}
+	
+	// Header with error/warning summary
+	headerText := fmt.Sprintf("📋 %s %s in %s (%d items)", 
+		info.Icon, m.selectedResource.String(), scopeStr, len(m.resources))
+	
+	if errorCount > 0 || warningCount > 0 {
+		headerText += fmt.Sprintf(" - ")
+		if errorCount > 0 {
+			headerText += lipgloss.NewStyle().Foreground(colors.Error).Render(fmt.Sprintf("%d errors", errorCount))
+		}
+		if errorCount > 0 && warningCount > 0 {
+			headerText += ", "
+		}
+		if warningCount > 0 {
+			headerText += lipgloss.NewStyle().Foreground(colors.Warning).Render(fmt.Sprintf("%d warnings", warningCount))
+		}
+	}
+	
+	successStyle := lipgloss.NewStyle().Foreground(colors.Success).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(colors.Text)
+	selectedStyle := colors.selection(colors.Secondary).Padding(0, 1)
+	
+	content.WriteString(successStyle.Render(headerText) + "\n")
+	if chips != "" {
+		content.WriteString(chips + "\n")
+	}
+	
+	// Usage metrics indicator for resources that report live usage
+	showUsage := m.metricsAvailable && (m.selectedResource == PodsResource || m.selectedResource == NodesResource)
+	if (m.selectedResource == PodsResource || m.selectedResource == NodesResource) && !m.metricsAvailable && m.metricsStatus != "" {
+		content.WriteString(lipgloss.NewStyle().Foreground(colors.Muted).Italic(true).Render("📉 Live usage unavailable: "+m.metricsStatus) + "\n")
+	}
+	
+	// Rows added, changed or deleted by the last refreshes
+	counts := map[string]int{"deleted": len(m.deletedRows())}
+	for _, resource := range m.resources {
+		if change, ok := m.rowChange(resource); ok {
+			counts[change.Change]++
+		}
+	}
+	if counts["added"]+counts["changed"]+counts["deleted"] > 0 {
+		var parts []string
+		for _, change := range []string{"added", "changed", "deleted"} {
+			if counts[change] > 0 {
+				parts = append(parts, changeStyle(change).Render(fmt.Sprintf("%s %d %s", changeMarker(change), counts[change], change)))
+			}
+		}
+		content.WriteString(strings.Join(parts, "  ") + lipgloss.NewStyle().Foreground(colors.Muted).Render(
+			fmt.Sprintf("  (press '%s' for the changelog)", m.keyHint(actionChangelog))) + "\n")
+	}
+	content.WriteString("\n")
+	
+	// Columns sized to the terminal; usage cells and the selection padding take their share
+	dividerWidth := m.width
+	if dividerWidth == 0 {
+		dividerWidth = 70 // fallback width
+	}
+	available := dividerWidth - 2
+	if showUsage {
+		available -= 38
+	}
+	order := m.sortOrders[m.selectedResource]
+	columns, widths := fitColumns(m.resourceColumns(m.selectedResource), m.resources, available, order.Column)
+	cellTexts := func(values func(i int, col TableColumn) string) []string {
+		cells := make([]string, len(columns))
+		for i, col := range columns {
+			value := values(i, col)
+			if utf8.RuneCountInString(value) > widths[i] {
+				value = truncateString(value, widths[i])
+			}
+			cells[i] = fmt.Sprintf("%-*s", widths[i], value)
+		}
+		return cells
+	}
+	renderCells := func(values func(i int, col TableColumn) string) string {
+		return strings.Join(cellTexts(values), " ")
+	}
+	
+	// Table header with the sort arrow on the sorted column
+	header := renderCells(func(i int, col TableColumn) string {
+		if col.Title != order.Column {
+			return col.Title
+		}
+		if order.Desc {
+			return col.Title + " ▼"
+		}
+		return col.Title + " ▲"
+	})
+	if showUsage {
+		header += fmt.Sprintf(" %-18s %-18s", "CPU", "MEMORY")
+	}
+	content.WriteString(normalStyle.Render(" "+header) + "\n")
+	dividerStyle := lipgloss.NewStyle().Foreground(colors.Muted)
+	content.WriteString(dividerStyle.Render(strings.Repeat("─", dividerWidth)) + "\n")
+	
+	// Table rows with color-coded status
+	matches := m.filterMatches()
+	for i, resource := range m.resources {
+		if matches != nil && !matches[i] {
+			continue
+		}
+		row := renderCells(func(_ int, col TableColumn) string { return col.Value(resource) })
+		change, changed := m.rowChange(resource)
+		
+		// Choose style based on resource health
+		var style lipgloss.Style
+		if i == m.cursor {
+			style = selectedStyle
+		} else if len(resource.Errors) > 0 {
+			style = lipgloss.NewStyle().Foreground(colors.Error)
+		} else if len(resource.Warnings) > 0 {
+			style = lipgloss.NewStyle().Foreground(colors.Warning)
+		} else {
+			style = normalStyle
+		}
+		
+		if i != m.cursor {
+			// The selected row's padding takes this space; rows changed by a refresh show a marker in it
+			if changed {
+				content.WriteString(changeStyle(change.Change).Render(changeMarker(change.Change)))
+			} else {
+				content.WriteString(" ")
+			}
+		}
+		if changed && change.Change == "changed" && i != m.cursor && !m.filterActive() {
+			// Emphasise the cells whose values changed
+			changedStyle := style.Bold(true).Underline(true)
+			cells := cellTexts(func(_ int, col TableColumn) string { return col.Value(resource) })
+			for c, col := range columns {
+				if c > 0 {
+					content.WriteString(style.Render(" "))
+				}
+				cellStyle := style
+				for _, f := range change.Fields {
+					if (col.Title == "STATUS" && f.Field == "Status") || (col.Detail != "" && f.Field == col.Detail) {
+						cellStyle = changedStyle
+					}
+				}
+				content.WriteString(cellStyle.Render(cells[c]))
+			}
+		} else {
+			content.WriteString(m.highlightMatches(row, style))
+		}
+		if i != m.cursor {
+			content.WriteString(" ")
+		}
+		if showUsage {
+			content.WriteString(" " + renderUsageCells(resource.Usage))
+		}
+		content.WriteString("\n")
+		content.WriteString(m.filterDetailHint(resource))
+		
+		// Show errors and warnings for selected resource
+		if i == m.cursor {
+			if changed {
+				label := fmt.Sprintf("  %s %s %s ago", changeMarker(change.Change), strings.ToUpper(change.Change[:1])+change.Change[1:], humanAge(time.Since(change.Time)))
+				if len(change.Fields) > 0 {
+					label += ": " + describeFieldChanges(change.Fields)
+				}
+				content.WriteString(changeStyle(change.Change).Render(label) + "\n")
+			}
+			if len(resource.Errors) > 0 {
+				for _, err := range resource.Errors {
+					content.WriteString(lipgloss.NewStyle().Foreground(colors.Error).Render("  ❌ " + err) + "\n")
+				}
+			}
+			if len(resource.Warnings) > 0 {
+				for _, warning := range resource.Warnings {
+					content.WriteString(lipgloss.NewStyle().Foreground(colors.Warning).Render("  ⚠️  " + warning) + "\n")
+				}
+			}
+			
+			// Show the details that have no visible column, in a stable order
+			var keys []string
+			for key := range resource.Details {
+				shown := false
+				for _, col := range columns {
+					shown = shown || col.Detail == key
+				}
+				if !shown {
+					keys = append(keys, key)
+				}
+			}
+			sort.Strings(keys)
+			if len(keys) > 0 {
+				content.WriteString(lipgloss.NewStyle().Foreground(colors.Info).Render("  📝 Details:") + "\n")
+				for _, key := range keys {
+					content.WriteString(fmt.Sprintf("     %s: %s\n", key, resource.Details[key]))
+				}
+			}
+			
+			// Per-container usage against requests and limits
+			if resource.Usage != nil && len(resource.Usage.Containers) > 0 {
+				content.WriteString(lipgloss.NewStyle().Foreground(colors.Info).Render("  📈 Container usage:") + "\n")
+				for _, cu := range resource.Usage.Containers {
+					content.WriteString(fmt.Sprintf("     %-20s %s\n", truncateString(cu.Name, 20), renderUsageCells(&cu)))
+				}
+			}
+			
+			// Session history of usage and restarts
+			if resource.ResourceType == PodsResource || resource.ResourceType == NodesResource {
+				content.WriteString(lipgloss.NewStyle().Foreground(colors.Info).Render("  📊 History:") + "\n")
+				content.WriteString(m.renderMetricHistory(resource, 30, "     "))
+			}
+		}
+	}
+	
+	// Resources deleted by the last refreshes stay visible briefly
+	ghostStyle := lipgloss.NewStyle().Foreground(colors.Muted).Strikethrough(true)
+	for _, resource := range m.deletedRows() {
+		row := renderCells(func(_ int, col TableColumn) string { return col.Value(resource) })
+		content.WriteString(changeStyle("deleted").Render(changeMarker("deleted")) + ghostStyle.Render(row) + "\n")
+	}
+	
+	return content.String()
+}
+
+// changeHighlightDuration is how long added, changed and deleted rows stay highlighted
+const changeHighlightDuration = 10 * time.Second
+
+// changelogLimit is the number of transitions kept per resource list
+const changelogLimit = 100
+
+// FieldChange is one field of a resource that changed between two loads
+type FieldChange struct {
+	Field string // "Status", "Issues" or a Details key
+	Old   string
+	New   string
+}
+
+// ChangeEntry is one transition of a resource list
+type ChangeEntry struct {
+	Time      time.Time
+	Change    string // added, changed or deleted
+	Kind      string
+	Namespace string
+	Name      string
+	Fields    []FieldChange // Changed fields, for "changed"
+}
+
+// changeTracker diffs successive loads of a resource list by UID.
+// It is shared by model copies and only changed in Update.
+type changeTracker struct {
+	list      string                   // List the snapshot belongs to
+	snapshot  map[string]K8sResource   // Previous load by UID
+	recent    map[string]ChangeEntry   // Latest added or changed entry per UID
+	deleted   []deletedRow             // Recently deleted resources, rendered as ghost rows
+	logs      map[string][]ChangeEntry // Changelog per list, oldest first
+}
+
+// deletedRow is a resource that disappeared from the list
+type deletedRow struct {
+	Entry    ChangeEntry
+	Resource K8sResource
+}
+
+// newChangeTracker creates an empty change tracker
+func newChangeTracker() *changeTracker {
+	return &changeTracker{logs: map[string][]ChangeEntry{}}
+}
+
+// resourceUID identifies a resource across loads: its UID, or namespace/name without a live object
+func resourceUID(res K8sResource) string {
+	if res.Object != nil {
+		if obj, err := meta.Accessor(res.Object); err == nil && obj.GetUID() != "" {
+			return string(obj.GetUID())
+		}
+	}
+	return resourceKey(res)
+}
+
+// resourceVersion returns the resourceVersion of the live object, if any
+func resourceVersion(res K8sResource) string {
+	if res.Object != nil {
+		if obj, err := meta.Accessor(res.Object); err == nil {
+			return obj.GetResourceVersion()
+		}
+	}
+	return ""
+}
+
+// changedFields compares the status, issues and details of two loads of a resource
+func changedFields(old, new K8sResource) []FieldChange {
+	// An unchanged object only differs in relative times such as "2m ago"
+	if v := resourceVersion(new); v != "" && v == resourceVersion(old) {
+		return nil
+	}
+	var fields []FieldChange
+	if old.Status != new.Status {
+		fields = append(fields, FieldChange{"Status", old.Status, new.Status})
+	}
+	oldIssues := strings.Join(append(append([]string{}, old.Errors...), old.Warnings...), "; ")
+	newIssues := strings.Join(append(append([]string{}, new.Errors...), new.Warnings...), "; ")
+	if oldIssues != newIssues {
+		fields = append(fields, FieldChange{"Issues", oldIssues, newIssues})
+	}
+	var keys []string
+	for key := range old.Details {
+		keys = append(keys, key)
+	}
+	for key := range new.Details {
+		if _, ok := old.Details[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if old.Details[key] != new.Details[key] {
+			fields = append(fields, FieldChange{key, old.Details[key], new.Details[key]})
+		}
+	}
+	return fields
+}
+
+// changeListKey identifies the resource list currently shown; changes are only tracked within one list
+func (m Model) changeListKey() string {
+	return fmt.Sprintf("%s|%s|%d|%s|%v", m.selectedKubeContext, m.selectedResource, m.selectedScope, m.selectedNamespace, m.selectors[m.selectedResource])
+}
+
+// track diffs a new load of the current list against the previous one and records the transitions
+func (t *changeTracker) track(list string, resources []K8sResource) {
+	now := time.Now()
+	current := make(map[string]K8sResource, len(resources))
+	for _, res := range resources {
+		current[resourceUID(res)] = res
+	}
+	if t.list != list {
+		// A different list: start over without highlighting everything as added
+		t.list, t.snapshot = list, current
+		t.recent, t.deleted = map[string]ChangeEntry{}, nil
+		return
+	}
+	
+	entry := func(change string, res K8sResource, fields []FieldChange) ChangeEntry {
+		return ChangeEntry{Time: now, Change: change, Kind: kindLabel(res.ResourceType), Namespace: res.Namespace, Name: res.Name, Fields: fields}
+	}
+	var entries []ChangeEntry
+	for _, res := range resources {
+		uid := resourceUID(res)
+		old, ok := t.snapshot[uid]
+		if !ok {
+			e := entry("added", res, nil)
+			t.recent[uid] = e
+			entries = append(entries, e)
+		} else if fields := changedFields(old, res); len(fields) > 0 {
+			e := entry("changed", res, fields)
+			t.recent[uid] = e
+			entries = append(entries, e)
+		}
+	}
+	var gone []string
+	for uid := range t.snapshot {
+		if _, ok := current[uid]; !ok {
+			gone = append(gone, uid)
+		}
+	}
+	sort.Slice(gone, func(i, j int) bool { return resourceKey(t.snapshot[gone[i]]) < resourceKey(t.snapshot[gone[j]]) })
+	for _, uid := range gone {
+		e := entry("deleted", t.snapshot[uid], nil)
+		t.deleted = append(t.deleted, deletedRow{Entry: e, Resource: t.snapshot[uid]})
+		delete(t.recent, uid)
+		entries = append(entries, e)
+	}
+	t.snapshot = current
+	
+	// Drop highlights that have expired
+	for uid, e := range t.recent {
+		if now.Sub(e.Time) >= changeHighlightDuration {
+			delete(t.recent, uid)
+		}
+	}
+	kept := t.deleted[:0]
+	for _, row := range t.deleted {
+		if now.Sub(row.Entry.Time) < changeHighlightDuration {
+			kept = append(kept, row)
+		}
+	}
+	t.deleted = kept
+	
+	history := append(t.logs[list], entries...)
+	if len(history) > changelogLimit {
+		history = history[len(history)-changelogLimit:]
+	}
+	t.logs[list] = history
+}
+
+// rowChange returns the recent change of a listed resource, if it is still highlighted
+func (m Model) rowChange(res K8sResource) (ChangeEntry, bool) {
+	if m.changes == nil || m.changes.list != m.changeListKey() {
+		return ChangeEntry{}, false
+	}
+	e, ok := m.changes.recent[resourceUID(res)]
+	if !ok || time.Since(e.Time) >= changeHighlightDuration {
+		return ChangeEntry{}, false
+	}
+	return e, true
+}
+
+// deletedRows returns the recently deleted resources of the current list, still shown as ghost rows
+func (m Model) deletedRows() []K8sResource {
+	if m.changes == nil || m.changes.list != m.changeListKey() {
+		return nil
+	}
+	var rows []K8sResource
+	for _, row := range m.changes.deleted {
+		if time.Since(row.Entry.Time) < changeHighlightDuration {
+			rows = append(rows, row.Resource)
+		}
+	}
+	return rows
+}
+
+// changeMarker is the gutter symbol of a changed row
+func changeMarker(change string) string {
+	switch change {
+	case "added":
+		return "+"
+	case "changed":
+		return "~"
+	case "deleted":
+		return "−"
+	}
+	return " "
+}
+
+// changeStyle colors the gutter symbol of a changed row
+func changeStyle(change string) lipgloss.Style {
+	switch change {
+	case "added":
+		return lipgloss.NewStyle().Foreground(colors.Success).Bold(true)
+	case "deleted":
+		return lipgloss.NewStyle().Foreground(colors.Error).Bold(true)
+	}
+	return lipgloss.NewStyle().Foreground(colors.Accent).Bold(true)
+}
+
+// describeFieldChanges renders field changes as "Restarts 3 → 4, Status Pending → Running"
+func describeFieldChanges(fields []FieldChange) string {
+	var parts []string
+	for _, f := range fields {
+		old, new := f.Old, f.New
+		if old == "" {
+			old = "∅"
+		}
+		if new == "" {
+			new = "∅"
+		}
+		parts = append(parts, fmt.Sprintf("%s %s → %s", f.Field, old, new))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// openChangelogView shows the transitions of the current resource list
+func (m Model) openChangelogView() (tea.Model, tea.Cmd) {
+	m.changelogList = m.changeListKey()
+	m.viewStack = append(m.viewStack, m.currentView)
+	m.currentView = ChangelogView
+	m.cursor = 0
+	return m, nil
+}
+
+// changelog returns the transitions of the list the changelog view was opened from, newest first
+func (m Model) changelog() []ChangeEntry {
+	if m.changes == nil {
+		return nil
+	}
+	entries := m.changes.logs[m.changelogList]
+	newest := make([]ChangeEntry, len(entries))
+	for i, e := range entries {
+		newest[len(entries)-1-i] = e
+	}
+	return newest
+}
+
+// renderChangelog lists the last transitions of a resource list
+func (m Model) renderChangelog() string {
+	var content strings.Builder
+	headerStyle := lipgloss.NewStyle().Foreground(colors.Success).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(colors.Text)
+	selectedStyle := colors.selection(colors.Secondary)
+	mutedStyle := lipgloss.NewStyle().Foreground(colors.Muted)
+	
+	entries := m.changelog()
+	content.WriteString(headerStyle.Render(fmt.Sprintf("🔄 Changes to %s in %s (last %d)", m.selectedResource, m.scopeLabel(), changelogLimit)) + "\n")
+	content.WriteString(mutedStyle.Render("Recorded on each refresh while the list is open") + "\n\n")
+	if len(entries) == 0 {
+		content.WriteString(normalStyle.Render("No changes yet") + "\n")
+		return content.String()
+	}
+	
+	visible := max(m.height-14, 5)
+	start := 0
+	if m.cursor >= visible {
+		start = m.cursor - visible + 1
+	}
+	for row := start; row < len(entries) && row < start+visible; row++ {
+		e := entries[row]
+		name := e.Name
+		if e.Namespace != "" {
+			name = e.Namespace + "/" + e.Name
+		}
+		line := fmt.Sprintf("%s %-8s %s %s", e.Time.Format("15:04:05"), e.Change, e.Kind, name)
+		if len(e.Fields) > 0 {
+			line += ": " + describeFieldChanges(e.Fields)
+		}
+		line = truncateString(line, max(m.width-4, 40))
+		if row == m.cursor {
+			content.WriteString(changeStyle(e.Change).Render(changeMarker(e.Change)) + " " + selectedStyle.Render(line) + "\n")
+		} else {
+			content.WriteString(changeStyle(e.Change).Render(changeMarker(e.Change)) + " " + normalStyle.Render(line) + "\n")
+		}
+	}
+	return content.String()
+}
+
+// TableColumn is one column of the resource table
+type TableColumn struct {
+	Title    string
+	Width    int  // Preferred maximum width
+	Min      int  // Width the column may shrink to before it is dropped
+	Priority int  // Lower priorities are kept longest when the terminal is narrow
+	Numeric  bool   // Sort by the leading number instead of text
+	Quantity bool   // Sort by the parsed resource quantity, e.g. 512Mi before 1Gi
+	Detail   string // Details key shown by the column, if any
+	Value    func(res K8sResource) string
+}
+
+// tableSort is the sort order of one resource type's table
+type tableSort struct {
+	Column string // Column title, empty for API order
+	Desc   bool
+}
+
+// detailColumn builds a column showing one Details entry
+func detailColumn(title, key string, width, priority int, numeric bool) TableColumn {
+	return TableColumn{Title: title, Width: width, Min: min(width, 8), Priority: priority, Numeric: numeric, Detail: key,
+		Value: func(res K8sResource) string { return res.Details[key] }}
+}
+
+// quantityColumn builds a column showing a Details entry that holds a resource quantity
+func quantityColumn(title, key string, width, priority int) TableColumn {
+	column := detailColumn(title, key, width, priority, false)
+	column.Quantity = true
+	return column
+}
+
+// resourceColumns returns the table columns of a resource type, in the configured layout if any
+func (m Model) resourceColumns(rt ResourceType) []TableColumn {
+	columns := m.availableColumns(rt)
+	layout, ok := m.settings().Columns[rt]
+	if !ok {
+		return columns
+	}
+	var result []TableColumn
+	for _, title := range layout {
+		for _, col := range columns {
+			if col.Title == title {
+				result = append(result, col)
+			}
+		}
+	}
+	if len(result) == 0 {
+		// e.g. only NAMESPACE configured while listing one namespace
+		return columns
+	}
+	return result
+}
+
+// availableColumns returns every table column of a resource type in default order
+func (m Model) availableColumns(rt ResourceType) []TableColumn {
+	columns := []TableColumn{
+		{Title: "NAME", Width: 50, Min: 16, Priority: 0, Value: func(res K8sResource) string { return res.Name }},
+	}
+	if rt.GetResourceInfo().Scope == NamespaceScoped && m.selectedNamespace == metav1.NamespaceAll {
+		columns = append(columns, TableColumn{Title: "NAMESPACE", Width: 25, Min: 10, Priority: 1,
+			Value: func(res K8sResource) string { return res.Namespace }})
+	}
+	status := TableColumn{Title: "STATUS", Width: 20, Min: 8, Priority: 1, Value: func(res K8sResource) string { return res.Status }}
+	
+	switch rt {
+	case PodsResource:
+		columns = append(columns,
+			detailColumn("READY", "Ready", 7, 1, true),
+			status,
+			detailColumn("RESTARTS", "Restarts", 8, 2, true),
+			detailColumn("NODE", "Node", 30, 3, false),
+			detailColumn("IP", "Pod IP", 16, 4, false))
+	case NodesResource:
+		columns = append(columns, status,
+			quantityColumn("CPU", "CPU", 6, 2),
+			quantityColumn("MEMORY", "Memory", 12, 2),
+			detailColumn("KERNEL", "Kernel", 30, 4, false),
+			detailColumn("RUNTIME", "Container Runtime", 25, 3, false))
+	case ServicesResource:
+		columns = append(columns,
+			detailColumn("TYPE", "Type", 12, 1, false),
+			detailColumn("CLUSTER-IP", "Cluster-IP", 16, 2, false),
+			detailColumn("PORTS", "Ports", 25, 2, false),
+			detailColumn("ENDPOINTS", "Endpoints", 12, 1, true))
+	case DeploymentsResource, DeploymentConfigsResource:
+		columns = append(columns,
+			detailColumn("READY", "Ready", 7, 1, true),
+			detailColumn("UP-TO-DATE", "Up-to-date", 10, 3, true),
+			detailColumn("AVAILABLE", "Available", 9, 2, true),
+			status)
+	case ReplicaSetsResource:
+		columns = append(columns,
+			detailColumn("READY", "Ready", 7, 1, true),
+			detailColumn("AVAILABLE", "Available", 9, 2, true),
+			status)
+	case DaemonSetsResource:
+		columns = append(columns,
+			detailColumn("DESIRED", "Desired", 7, 2, true),
+			detailColumn("READY", "Ready", 7, 1, true),
+			status)
+	case StatefulSetsResource:
+		columns = append(columns,
+			detailColumn("READY", "Ready", 7, 1, true),
+			detailColumn("SERVICE", "Service", 20, 3, false),
+			status)
+	case IngressResource:
+		columns = append(columns,
+			detailColumn("CLASS", "Class", 12, 3, false),
+			detailColumn("HOSTS", "Hosts", 35, 2, false),
+			detailColumn("ADDRESS", "Address", 20, 2, false),
+			status)
+	case PersistentVolumeClaimsResource:
+		columns = append(columns, status,
+			detailColumn("VOLUME", "Volume", 30, 3, false),
+			quantityColumn("CAPACITY", "Capacity", 9, 2),
+			detailColumn("ACCESS MODES", "AccessModes", 14, 3, false),
+			detailColumn("STORAGECLASS", "StorageClass", 15, 2, false))
+	case JobsResource:
+		columns = append(columns,
+			detailColumn("COMPLETIONS", "Completions", 11, 1, true),
+			detailColumn("ACTIVE", "Active", 6, 3, true),
+			detailColumn("FAILED", "Failed", 6, 2, true),
+			detailColumn("DURATION", "Duration", 9, 2, false),
+			status)
+	case CronJobsResource:
+		columns = append(columns,
+			detailColumn("SCHEDULE", "Schedule", 15, 1, false),
+			detailColumn("LAST SCHEDULE", "LastSchedule", 13, 2, false),
+			detailColumn("NEXT RUN", "NextRun", 20, 3, false),
+			detailColumn("ACTIVE", "Active", 6, 3, true),
+			status)
+	case RoutesResource:
+		columns = append(columns,
+			detailColumn("HOST", "Host", 40, 1, false),
+			detailColumn("PATH", "Path", 15, 3, false),
+			detailColumn("SERVICE", "Service", 20, 2, false),
+			status)
+	case ProjectsResource:
+		columns = append(columns, status,
+			detailColumn("REQUESTER", "Requester", 20, 3, false))
+	default:
+		columns = append(columns, status)
+	}
+	
+	return append(columns, TableColumn{Title: "AGE", Width: 6, Min: 4, Priority: 1,
+		Value: func(res K8sResource) string { return res.Age }})
+}
+
+// fitColumns sizes columns to their content and shrinks or drops them to fit the available width.
+// The sorted column gets room for its sort arrow.
+func fitColumns(columns []TableColumn, resources []K8sResource, available int, sorted string) ([]TableColumn, []int) {
+	titleWidth := func(col TableColumn) int {
+		if col.Title == sorted {
+			return len(col.Title) + 2
+		}
+		return len(col.Title)
+	}
+	for {
+		widths := make([]int, len(columns))
+		total := len(columns) - 1 // One space between columns
+		for i, col := range columns {
+			widths[i] = titleWidth(col)
+			for _, res := range resources {
+				widths[i] = max(widths[i], len(col.Value(res)))
+			}
+			widths[i] = min(widths[i], max(col.Width, titleWidth(col)))
+			total += widths[i]
+		}
+	
+		// Shrink the widest shrinkable column until the table fits
+		for total > available {
+			widest := -1
+			for i, col := range columns {
+				if widths[i] > max(col.Min, titleWidth(col)) && (widest < 0 || widths[i] > widths[widest]) {
+					widest = i
+				}
+			}
+			if widest < 0 {
+				break
+			}
+			widths[widest]--
+			total--
+		}
+		if total <= available || len(columns) <= 1 {
+			return columns, widths
+		}
+	
+		// Still too wide: drop the least important column
+		drop := len(columns) - 1
+		for i, col := range columns {
+			if col.Priority >= columns[drop].Priority {
+				drop = i
+			}
+		}
+		columns = append(columns[:drop:drop], columns[drop+1:]...)
+	}
+}
+
+// resourceCreated returns the creation time of a resource, if known
+func resourceCreated(res K8sResource) (time.Time, bool) {
+	if res.Object == nil {
+		return time.Time{}, false
+	}
+	obj, err := meta.Accessor(res.Object)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return obj.GetCreationTimestamp().Time, true
+}
+
+// leadingNumber parses the number at the start of a value, e.g. 3 in "3/4" or "3 (2m ago)"
+func leadingNumber(value string) (float64, bool) {
+	end := 0
+	for end < len(value) && (value[end] >= '0' && value[end] <= '9' || value[end] == '.') {
+		end++
+	}
+	n, err := strconv.ParseFloat(value[:end], 64)
+	return n, err == nil
+}
+
+// resourceKey identifies a resource across reloads
+func resourceKey(res K8sResource) string {
+	return res.Namespace + "/" + res.Name
+}
+
+// moveCursorTo puts the cursor on the resource with the given key, if it is still listed
+func (m Model) moveCursorTo(key string) Model {
+	for i, res := range m.resources {
+		if resourceKey(res) == key {
+			m.cursor = i
+		}
+	}
+	return m
+}
+
+// parseAge converts an age as shown in the table ("5d", "3h", ...) back to a duration
+func parseAge(age string) time.Duration {
+	if len(age) < 2 {
+		return 0
+	}
+	n, err := strconv.Atoi(age[:len(age)-1])
+	if err != nil {
+		return 0
+	}
+	units := map[byte]time.Duration{'d': 24 * time.Hour, 'h': time.Hour, 'm': time.Minute, 's': time.Second}
+	return time.Duration(n) * units[age[len(age)-1]]
+}
+
+// sortResources orders resources by the sort column of their type
+func (m Model) sortResources() Model {
+	order, ok := m.sortOrders[m.selectedResource]
+	if !ok || order.Column == "" {
+		return m
+	}
+	var column *TableColumn
+	for _, col := range m.resourceColumns(m.selectedResource) {
+		if col.Title == order.Column {
+			column = &col
+			break
+		}
+	}
+	if column == nil {
+		return m
+	}
+	
+	// compare returns <0, 0 or >0 in ascending order
+	compare := func(a, b K8sResource) int {
+		if column.Title == "AGE" {
+			ta, okA := resourceCreated(a)
+			tb, okB := resourceCreated(b)
+			if okA && okB {
+				return tb.Compare(ta) // Newer objects are younger
+			}
+			return int(parseAge(a.Age) - parseAge(b.Age))
+		}
+		va, vb := column.Value(a), column.Value(b)
+		if column.Quantity {
+			qa, errA := resource.ParseQuantity(va)
+			qb, errB := resource.ParseQuantity(vb)
+			switch {
+			case errA == nil && errB == nil:
+				return qa.Cmp(qb)
+			case (errA == nil) != (errB == nil):
+				// Rows without a quantity sort last
+				if errA == nil {
+					return -1
+				}
+				return 1
+			}
+		}
+		if column.Numeric {
+			na, okA := leadingNumber(va)
+			nb, okB := leadingNumber(vb)
+			switch {
+			case okA && okB && na != nb:
+				if na < nb {
+					return -1
+				}
+				return 1
+			case okA != okB:
+				// Rows without a number sort last
+				if okA {
+					return -1
+				}
+				return 1
+			}
+		}
+		return strings.Compare(va, vb)
+	}
+	
+	resources := append([]K8sResource(nil), m.resources...)
+	sort.SliceStable(resources, func(i, j int) bool {
+		c := compare(resources[i], resources[j])
+		if c == 0 {
+			// Stable tie-breaker so refreshes don't reshuffle equal rows
+			return resourceKey(resources[i]) < resourceKey(resources[j])
+		}
+		if order.Desc {
+			return c > 0
+		}
+		return c < 0
+	})
+	m.resources = resources
+	return m
+}
+
+// cycleSortColumn moves the sort column of the current resource type left (-1) or right (1);
+// moving past either end returns to API order
+func (m Model) cycleSortColumn(dir int) Model {
+	columns := m.resourceColumns(m.selectedResource)
+	order := m.sortOrders[m.selectedResource]
+	current := -1
+	for i, col := range columns {
+		if col.Title == order.Column {
+			current = i
+		}
+	}
+	next := current + dir
+	if current < 0 && dir < 0 {
+		next = len(columns) - 1
+	}
+	if next < 0 || next >= len(columns) {
+		// Back to the order returned by the API server, which needs a reload
+		delete(m.sortOrders, m.selectedResource)
+		m.loading = true
+		return m
+	}
+	order.Column = columns[next].Title
+	m.sortOrders[m.selectedResource] = order
+	return m.sortResources()
+}
+
+// renderUsageCells renders CPU and memory usage cells colored by their threshold level
+func renderUsageCells(usage *UsageMetrics) string {
+	if usage == nil {
+		return lipgloss.NewStyle().Foreground(colors.Muted).Render(fmt.Sprintf("%-18s %-18s", "-", "-"))
+	}
+	cell := func(value string, pct, limitPct int) string {
+		// Limits are the hard ceiling, so they drive the color when set
+		level := pct
+		text := value
+		if limitPct >= 0 {
+			level = limitPct
+			text = fmt.Sprintf("%s %d%%L", value, limitPct)
+		} else if pct >= 0 {
+			text = fmt.Sprintf("%s %d%%", value, pct)
+		}
+		return lipgloss.NewStyle().Foreground(usageLevelColor(level)).Render(fmt.Sprintf("%-18s", text))
+	}
+	return cell(formatCPU(usage.CPU), usage.CPUPercent, usage.CPULimitPct) + " " +
+		cell(formatBytes(usage.Memory), usage.MemoryPercent, usage.MemoryLimitPct)
+}
+
+// buildFeaturesText shows available features and actions for current view
+func (m Model) buildFeaturesText() string {
+	var features []string
+	featureStyle := lipgloss.NewStyle().Foreground(colors.Info).Bold(true)
+	actionStyle := lipgloss.NewStyle().Foreground(colors.Success)
+	deniedStyle := lipgloss.NewStyle().Foreground(colors.Muted)
+	
+	// permitted greys out actions the current user may not perform
+	permitted := func(action, text string) string {
+		if access := m.actionAccess(action); !access.Allowed {
+			return deniedStyle.Render(text + " 🚫 " + access.Reason)
+		}
+		return actionStyle.Render(text)
+	}
+	
+	switch m.currentView {
+	case DetailView:
+		if len(m.resources) > 0 && m.cursor < len(m.resources) {
+			selectedResource := &m.resources[m.cursor]
+			info := selectedResource.ResourceType.GetResourceInfo()
+			
+			features = append(features, featureStyle.Render("Available Actions:"))
+			
+			if info.SupportsLogs {
+				features = append(features, permitted("logs", fmt.Sprintf("  📜 Press '%s' - View logs for selected resource", m.keyHint(actionLogs))))
+			}
+			if info.SupportsEvents {
+				features = append(features, permitted("events", fmt.Sprintf("  📢 Press '%s' - View events for selected resource", m.keyHint(actionEvents))))
+			}
+			if selectedResource.ResourceType == PodsResource {
+				features = append(features, permitted("exec", fmt.Sprintf("  💻 Press '%s' - Open a shell in the pod (kubectl exec)", m.keyHint(actionExec))))
+			}
+			if selectedResource.ResourceType == CronJobsResource {
+				features = append(features, permitted("trigger", fmt.Sprintf("  ▶️  Press '%s' - Trigger a Job from this CronJob now", m.keyHint(actionTrigger))))
+				if selectedResource.Status == "Suspended" {
+					features = append(features, permitted("suspend", fmt.Sprintf("  ⏯️  Press '%s' - Resume this CronJob", m.keyHint(actionSuspend))))
+				} else {
+					features = append(features, permitted("suspend", fmt.Sprintf("  ⏸️  Press '%s' - Suspend this CronJob", m.keyHint(actionSuspend))))
+				}
+				features = append(features, actionStyle.Render(fmt.Sprintf("  🕘 Press '%s' - View run history (Jobs, pods, logs)", m.keyHint(actionHistory))))
+			}
+			if supportsTrafficPath(selectedResource.ResourceType) {
+				features = append(features, actionStyle.Render(fmt.Sprintf("  🚦 Press '%s' - Trace traffic path down to endpoints and pods", m.keyHint(actionTraffic))))
+			}
+			if supportsOwnershipTree(selectedResource.ResourceType) {
+				features = append(features, actionStyle.Render(fmt.Sprintf("  🌳 Press '%s' - Show ownership tree (controller ↔ owned objects)", m.keyHint(actionOwnership))))
+			}
+			features = append(features, actionStyle.Render(fmt.Sprintf("  🏷️  Press '%s' / '%s' - Filter by label / field selector (server-side)",
+				m.keyHint(actionLabelSelector), m.keyHint(actionFieldSelector))))
+			features = append(features, actionStyle.Render(fmt.Sprintf("  ↕️  Press '%s' / '%s' - Sort by the previous / next column, '%s' - Reverse the order",
+				m.keyHint(actionSortPrev), m.keyHint(actionSortNext), m.keyHint(actionSortReverse))))
+			features = append(features, actionStyle.Render(fmt.Sprintf("  🔲 Press '%s' - Switch to multi-frame view", m.keyHint(actionMultiFrame))))
+			features = append(features, actionStyle.Render(fmt.Sprintf("  🔄 Press '%s' - Refresh resource list", m.keyHint(actionRefresh))))
+			features = append(features, actionStyle.Render(fmt.Sprintf("  ⚡ Press '%s' - Toggle auto-refresh", m.keyHint(actionAutoRefresh))))
+		}
+	case MultiFrameView:
+		features = append(features, featureStyle.Render("Multi-Frame Features:"))
+		features = append(features, actionStyle.Render(fmt.Sprintf("  🔄 Press '%s' - Switch between Resource, Log, Event and Metrics frames", m.keyHint(actionNextFrame))))
+		features = append(features, actionStyle.Render("  📦 Resource Frame - Navigate and select resources"))
+		features = append(features, actionStyle.Render("  📜 Log Frame - View real-time logs"))
+		features = append(features, actionStyle.Render("  📢 Event Frame - View Kubernetes events"))
+		features = append(features, actionStyle.Render("  📈 Metrics Frame - CPU, memory and restart sparklines for this session"))
+	case KubernetesContextView:
+		features = append(features, featureStyle.Render("Context Selection:"))
+		features = append(features, actionStyle.Render("  🔧 Select cluster context to connect"))
+		features = append(features, actionStyle.Render("  ✅ Current active context is marked"))
+		features = append(features, actionStyle.Render("  🚀 Auto-switches kubectl context on selection"))
+	case ResourceView:
+		features = append(features, featureStyle.Render("Resource Types Available:"))
+		if m.isOpenShift {
+			features = append(features, actionStyle.Render("  🔗 OpenShift Routes, DeploymentConfigs, Projects"))
+		}
+		features = append(features, actionStyle.Render("  🚪 Gateway API resources (Gateways, HTTPRoutes)"))
+		features = append(features, actionStyle.Render("  🛡️ Network Policies, Autoscalers"))
+		features = append(features, actionStyle.Render("  🐳 Pods, Services, Deployments, and more"))
+		features = append(features, deniedStyle.Render(fmt.Sprintf("  🚫 Greyed-out types are not permitted for your user (press '%s' to re-check)", m.keyHint(actionRefresh))))
+	}
+	
+	if len(features) == 0 {
+		return ""
+	}
+	
+	// Center the features text
+	var centeredFeatures []string
+	for _, feature := range features {
+		if m.width > 0 {
+			// Remove styles for length calculation
+			plainText := lipgloss.NewStyle().Render(feature)
+			padding := (m.width - len(plainText)) / 2
+			if padding > 0 {
+				feature = strings.Repeat(" ", padding) + feature
+			}
+		}
+		centeredFeatures = append(centeredFeatures, feature)
+	}
+	
+	return strings.Join(centeredFeatures, "\n")
+}
+
+// Actions that can be bound to keys
+const (
+	actionClose         = "close"
+	actionQuit          = "quit"
+	actionBack          = "back"
+	actionUp            = "up"
+	actionDown          = "down"
+	actionSelect        = "select"
+	actionLogs          = "logs"
+	actionEvents        = "events"
+	actionExec          = "exec"
+	actionTrigger       = "trigger"
+	actionSuspend       = "suspend"
+	actionHistory       = "history"
+	actionOwnership     = "ownership"
+	actionTraffic       = "traffic"
+	actionLabelSelector = "label-selector"
+	actionFieldSelector = "field-selector"
+	actionSortPrev      = "sort-prev"
+	actionSortNext      = "sort-next"
+	actionSortReverse   = "sort-reverse"
+	actionMultiFrame    = "multi-frame"
+	actionNextFrame     = "next-frame"
+	actionRefresh       = "refresh"
+	actionAutoRefresh   = "auto-refresh"
+	actionFilter        = "filter"
+	actionCommand       = "command"
+	actionConfig        = "config"
+	actionTheme         = "theme"
+	actionNotifications = "notifications"
+	actionChangelog     = "changelog"
+	actionContainer     = "container"
+	actionReplayPrev    = "replay-prev"
+	actionReplayNext    = "replay-next"
+	actionHelp          = "help"
+)
+
+// KeyAction describes an action that can be bound to keys
+type KeyAction struct {
+	Name     string
+	Help     string
+	Keys     []string            // Default keys
+	Views    []ViewType          // Views the action applies to; nil for every view
+	ViewHelp map[ViewType]string // Help text in views where the action does something more specific
+	Global   bool                // Shown under "Everywhere" in the help overlay instead of the footer
+}
+
+// listViews are the views with a cursor over a list
+var listViews = []ViewType{KubernetesContextView, ClusterOrNamespaceView, NamespaceView, ResourceView, DetailView,
+	MultiFrameView, CronJobHistoryView, OwnershipTreeView, TrafficPathView, DashboardView, CompareView, CompareDiffView}
+
+// keyActions are the bindable actions with their default keys, in help order
+var keyActions = []KeyAction{
+	{Name: actionUp, Help: "up", Keys: []string{"up", "k"},
+		ViewHelp: map[ViewType]string{LogView: "scroll up", EventView: "scroll up"}},
+	{Name: actionDown, Help: "down", Keys: []string{"down", "j"},
+		ViewHelp: map[ViewType]string{LogView: "scroll down", EventView: "scroll down"}},
+	{Name: actionSelect, Help: "select", Keys: []string{"enter", " ", "right", "l"}, Views: listViews,
+		ViewHelp: map[ViewType]string{DashboardView: "open object", CronJobHistoryView: "pod logs",
+			OwnershipTreeView: "pod logs", TrafficPathView: "pod logs", CompareView: "field diff"}},
+	{Name: actionLogs, Help: "view logs", Keys: []string{"v"},
+		Views: []ViewType{DetailView, CronJobHistoryView, OwnershipTreeView, TrafficPathView}},
+	{Name: actionEvents, Help: "view events", Keys: []string{"e"},
+		Views: []ViewType{DetailView, CronJobHistoryView, OwnershipTreeView, TrafficPathView, DashboardView}},
+	{Name: actionExec, Help: "exec shell", Keys: []string{"x"}, Views: []ViewType{DetailView}},
+	{Name: actionTrigger, Help: "trigger", Keys: []string{"t"}, Views: []ViewType{DetailView}},
+	{Name: actionSuspend, Help: "suspend/resume", Keys: []string{"s"}, Views: []ViewType{DetailView}},
+	{Name: actionHistory, Help: "history", Keys: []string{"H"}, Views: []ViewType{DetailView}},
+	{Name: actionOwnership, Help: "ownership tree", Keys: []string{"o"}, Views: []ViewType{DetailView, OwnershipTreeView},
+		ViewHelp: map[ViewType]string{OwnershipTreeView: "jump to controller/origin"}},
+	{Name: actionTraffic, Help: "traffic path", Keys: []string{"p"}, Views: []ViewType{DetailView}},
+	{Name: actionLabelSelector, Help: "label selector", Keys: []string{"L"}, Views: []ViewType{DetailView, MultiFrameView}},
+	{Name: actionFieldSelector, Help: "field selector", Keys: []string{"F"}, Views: []ViewType{DetailView, MultiFrameView}},
+	{Name: actionSortPrev, Help: "prev sort column", Keys: []string{"<"}, Views: []ViewType{DetailView, MultiFrameView}},
+	{Name: actionSortNext, Help: "next sort column", Keys: []string{">"}, Views: []ViewType{DetailView, MultiFrameView}},
+	{Name: actionSortReverse, Help: "reverse sort", Keys: []string{"S"}, Views: []ViewType{DetailView, MultiFrameView}},
+	{Name: actionMultiFrame, Help: "multi-frame", Keys: []string{"m"}, Views: []ViewType{DetailView}},
+	{Name: actionChangelog, Help: "changelog", Keys: []string{"c"}, Views: []ViewType{DetailView, MultiFrameView}},
+	{Name: actionNextFrame, Help: "switch frame", Keys: []string{"tab"}, Views: []ViewType{MultiFrameView}},
+	{Name: actionContainer, Help: "next container", Keys: []string{"tab"}, Views: []ViewType{LogView}},
+	{Name: actionRefresh, Help: "refresh", Keys: []string{"r"},
+		Views: []ViewType{NamespaceView, ResourceView, DetailView, LogView, EventView, CronJobHistoryView,
+			OwnershipTreeView, TrafficPathView, DashboardView, CompareView, CompareDiffView},
+		ViewHelp: map[ViewType]string{ResourceView: "re-check permissions", DashboardView: "rescan",
+			CompareView: "compare again", CompareDiffView: "compare again"}},
+	{Name: actionAutoRefresh, Help: "toggle auto-refresh", Keys: []string{"a"},
+		Views: []ViewType{DetailView, LogView, EventView, MultiFrameView, CronJobHistoryView}},
+	{Name: actionFilter, Help: "filter", Keys: []string{"/"},
+		Views: []ViewType{KubernetesContextView, ClusterOrNamespaceView, NamespaceView, ResourceView, DetailView,
+			MultiFrameView, CronJobHistoryView, DashboardView, CompareView}},
+	{Name: actionBack, Help: "back", Keys: []string{"esc", "left", "backspace", "h"}},
+	{Name: actionCommand, Help: "command palette", Keys: []string{":"}, Global: true},
+	{Name: actionConfig, Help: "effective configuration", Keys: []string{"C"}, Global: true},
+	{Name: actionTheme, Help: "switch theme", Keys: []string{"T"}, Global: true},
+	{Name: actionNotifications, Help: "notification history", Keys: []string{"N"}, Global: true},
+	{Name: actionReplayPrev, Help: "earlier snapshot frame (--replay)", Keys: []string{"["}, Global: true},
+	{Name: actionReplayNext, Help: "later snapshot frame (--replay)", Keys: []string{"]"}, Global: true},
+	{Name: actionHelp, Help: "help", Keys: []string{"?"}},
+	{Name: actionClose, Help: "back", Keys: []string{"q"}},
+	{Name: actionQuit, Help: "quit", Keys: []string{"Q"}, Global: true},
+}
+
+// viewKeyNames name the views in the keys section of the config file
+var viewKeyNames = map[ViewType]string{
+	KubernetesContextView:  "contexts",
+	ClusterOrNamespaceView: "scope",
+	NamespaceView:          "namespaces",
+	ResourceView:           "resource-types",
+	DetailView:             "resources",
+	LogView:                "logs",
+	EventView:              "events",
+	MultiFrameView:         "multi-frame",
+	CronJobHistoryView:     "cronjob-history",
+	OwnershipTreeView:      "ownership-tree",
+	TrafficPathView:        "traffic-path",
+	DashboardView:          "dashboard",
+	ConfigView:             "config",
+	NotificationsView:      "notifications",
+	ChangelogView:          "changelog",
+	CompareView:            "compare",
+	CompareDiffView:        "compare-diff",
+}
+
+// namedKeys are the multi-character key names accepted in the config, besides ctrl+ and alt+ combinations
+var namedKeys = []string{"up", "down", "left", "right", "enter", "esc", "backspace", "tab", "shift+tab", "space",
+	"home", "end", "pgup", "pgdown", "delete", "insert",
+	"f1", "f2", "f3", "f4", "f5", "f6", "f7", "f8", "f9", "f10", "f11", "f12"}
+
+// Keymap holds the keys bound to each action, per view
+type Keymap map[ViewType]map[string][]string
+
+// validKey reports whether a config key name is one k8sGo can receive
+func validKey(key string) bool {
+	if utf8.RuneCountInString(key) == 1 || containsString(namedKeys, key) {
+		return true
+	}
+	for _, prefix := range []string{"ctrl+", "alt+"} {
+		if rest := strings.TrimPrefix(key, prefix); rest != key {
+			return validKey(rest)
+		}
+	}
+	return false
+}
+
+// buildKeymap applies the key overrides from the config to the default bindings.
+// Overrides are keyed by "global" or a view name, then by action; global overrides apply in every view.
+func buildKeymap(overrides map[string]map[string][]string) (Keymap, []string) {
+	var problems []string
+	views := map[string]ViewType{}
+	for view, name := range viewKeyNames {
+		views[name] = view
+	}
+	actions := map[string]KeyAction{}
+	for _, action := range keyActions {
+		actions[action.Name] = action
+	}
+	appliesTo := func(action KeyAction, view ViewType) bool {
+		return action.Views == nil || containsViewType(action.Views, view)
+	}
+	
+	scopes := make([]string, 0, len(overrides))
+	for scope := range overrides {
+		scopes = append(scopes, scope)
+	}
+	sort.Strings(scopes)
+	for _, scope := range scopes {
+		if _, ok := views[scope]; !ok && scope != "global" {
+			names := make([]string, 0, len(views))
+			for name := range views {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			problems = append(problems, fmt.Sprintf("keys.%s: unknown view (use global or one of: %s)", scope, strings.Join(names, ", ")))
+			continue
+		}
+		names := make([]string, 0, len(overrides[scope]))
+		for name := range overrides[scope] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			keys := overrides[scope][name]
+			action, ok := actions[name]
+			if !ok {
+				problems = append(problems, fmt.Sprintf("keys.%s.%s: unknown action", scope, name))
+				continue
+			}
+			if view, ok := views[scope]; ok && !appliesTo(action, view) {
+				problems = append(problems, fmt.Sprintf("keys.%s.%s: action does nothing in this view", scope, name))
+			}
+			for _, key := range keys {
+				if !validKey(key) {
+					problems = append(problems, fmt.Sprintf("keys.%s.%s: unknown key %q", scope, name, key))
+				}
+			}
+		}
+	}
+	
+	keymap := Keymap{}
+	for view := range viewKeyNames {
+		bindings := map[string][]string{}
+		for _, action := range keyActions {
+			if !appliesTo(action, view) {
+				continue
+			}
+			keys := action.Keys
+			if override, ok := overrides["global"][action.Name]; ok {
+				keys = override
+			}
+			if override, ok := overrides[viewKeyNames[view]][action.Name]; ok {
+				keys = override
+			}
+			normalized := make([]string, len(keys))
+			for i, key := range keys {
+				if key == "space" {
+					key = " "
+				}
+				normalized[i] = key
+			}
+			bindings[action.Name] = normalized
+		}
+		keymap[view] = bindings
+	}
+	
+	// A key may only trigger one action per view
+	for _, name := range sortedViewNames() {
+		view := views[name]
+		owner := map[string]string{}
+		for _, action := range keyActions {
+			for _, key := range keymap[view][action.Name] {
+				if other, taken := owner[key]; taken {
+					problems = append(problems, fmt.Sprintf("keys: %q is bound to both %s and %s in the %s view", keyLabel(key), other, action.Name, name))
+					continue
+				}
+				owner[key] = action.Name
+			}
+		}
+	}
+	return keymap, problems
+}
+
+// sortedViewNames returns the config names of the views in ViewType order
+func sortedViewNames() []string {
+	views := make([]ViewType, 0, len(viewKeyNames))
+	for view := range viewKeyNames {
+		views = append(views, view)
+	}
+	sort.Slice(views, func(i, j int) bool { return views[i] < views[j] })
+	names := make([]string, len(views))
+	for i, view := range views {
+		names[i] = viewKeyNames[view]
+	}
+	return names
+}
+
+// containsViewType reports whether list contains view
+func containsViewType(list []ViewType, view ViewType) bool {
+	for _, item := range list {
+		if item == view {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultKeymap is used when no keymap was built, e.g. for scoped model copies
+var defaultKeymap, _ = buildKeymap(nil)
+
+// keys returns the keymap in force
+func (m Model) keys() Keymap {
+	if m.keymap == nil {
+		return defaultKeymap
+	}
+	return m.keymap
+}
+
+// keyAction returns the action bound to a key in the current view, or ""
+func (m Model) keyAction(key string) string {
+	for _, action := range keyActions {
+		if containsString(m.keys()[m.currentView][action.Name], key) {
+			return action.Name
+		}
+	}
+	return ""
+}
+
+// keyLabel returns the display name of a key
+func keyLabel(key string) string {
+	switch key {
+	case "up":
+		return "↑"
+	case "down":
+		return "↓"
+	case "left":
+		return "←"
+	case "right":
+		return "→"
+	case " ":
+		return "space"
+	}
+	return key
+}
+
+// keyHint returns the first key of an action in the current view, for inline hints
+func (m Model) keyHint(action string) string {
+	keys := m.keys()[m.currentView][action]
+	if len(keys) == 0 {
+		return "unbound"
+	}
+	return keyLabel(keys[0])
+}
+
+// actionHelp returns the help text of an action in the current view, or "" when it does nothing there
+func (m Model) actionHelp(action KeyAction) string {
+	if help, ok := action.ViewHelp[m.currentView]; ok {
+		return help
+	}
+	switch action.Name {
+	case actionClose:
+		if len(m.viewStack) == 0 {
+			return "quit"
+		}
+	case actionBack:
+		if len(m.viewStack) == 0 {
+			return ""
+		}
+	case actionFilter:
+		if !m.supportsFilter() {
+			return ""
+		}
+		if m.filterActive() {
+			return "edit filter, esc: clear filter"
+		}
+	}
+	if m.currentView != DetailView {
+		return action.Help
+	}
+	
+	// Actions on the selected resource depend on its kind
+	ok := m.cursor < len(m.resources)
+	var selected K8sResource
+	if ok {
+		selected = m.resources[m.cursor]
+	}
+	info := selected.ResourceType.GetResourceInfo()
+	switch action.Name {
+	case actionLogs:
+		ok = ok && info.SupportsLogs
+	case actionEvents:
+		ok = ok && info.SupportsEvents
+	case actionExec:
+		ok = ok && selected.ResourceType == PodsResource
+	case actionTrigger, actionSuspend, actionHistory:
+		ok = ok && selected.ResourceType == CronJobsResource
+	case actionOwnership:
+		ok = ok && supportsOwnershipTree(selected.ResourceType)
+	case actionTraffic:
+		ok = ok && supportsTrafficPath(selected.ResourceType)
+	default:
+		ok = true
+	}
+	if !ok {
+		return ""
+	}
+	return action.Help
+}
+
+// buildHelpText creates the footer help line from the keymap of the current view
+func (m Model) buildHelpText() string {
+	// Actions with the same help share an entry, e.g. "esc/q: back"
+	var labels []string
+	keysByLabel := map[string][]string{}
+	for _, action := range keyActions {
+		help := m.actionHelp(action)
+		keys := m.keys()[m.currentView][action.Name]
+		if action.Global || help == "" || len(keys) == 0 {
+			continue
+		}
+		if _, seen := keysByLabel[help]; !seen {
+			labels = append(labels, help)
+		}
+		// Two keys per action keep the footer on one line; the overlay lists them all
+		for _, key := range keys[:min(len(keys), 2)] {
+			keysByLabel[help] = append(keysByLabel[help], keyLabel(key))
+		}
+	}
+	
+	help := make([]string, len(labels))
+	for i, label := range labels {
+		help[i] = strings.Join(keysByLabel[label], "/") + ": " + label
+	}
+	return strings.Join(help, " | ")
+}
+
+// renderKeyHelp renders the '?' overlay listing every binding of the current view
+func (m Model) renderKeyHelp() string {
+	var content strings.Builder
+	headerStyle := lipgloss.NewStyle().Foreground(colors.Success).Bold(true)
+	keyStyle := lipgloss.NewStyle().Foreground(colors.Info).Bold(true)
+	mutedStyle := lipgloss.NewStyle().Foreground(colors.Text).Faint(true).Italic(true)
+	
+	section := func(title string, global bool) {
+		content.WriteString(headerStyle.Render(title) + "\n")
+		for _, action := range keyActions {
+			help := m.actionHelp(action)
+			keys, applies := m.keys()[m.currentView][action.Name]
+			if action.Global != global || help == "" || !applies {
+				continue
+			}
+			labels := make([]string, len(keys))
+			for i, key := range keys {
+				labels[i] = keyLabel(key)
+			}
+			bound := strings.Join(labels, ", ")
+			if bound == "" {
+				bound = "(unbound)"
+			}
+			content.WriteString(fmt.Sprintf("  %s %s %s\n", keyStyle.Render(fmt.Sprintf("%-22s", bound)), fmt.Sprintf("%-28s", help), mutedStyle.Render(action.Name)))
+		}
+		content.WriteString("\n")
+	}
+	section(fmt.Sprintf("⌨️  Keys in this view (%s)", viewKeyNames[m.currentView]), false)
+	section("Everywhere", true)
+	content.WriteString(fmt.Sprintf("  %s %s\n\n", keyStyle.Render(fmt.Sprintf("%-22s", "ctrl+c")), "quit from anywhere"))
+	
+	content.WriteString(mutedStyle.Render(fmt.Sprintf("Remap keys under 'keys:' in %s, globally or per view, e.g. keys: {global: {logs: [l]}, %s: {...}}. Press any key to close.",
+		configFilePath(), viewKeyNames[m.currentView])) + "\n")
+	return content.String()
+}
+
+// Placeholder functions for resource loading - implement based on your needs
+func (m Model) loadNodes() ([]K8sResource, error) {
+	nodes, err := m.clientset.CoreV1().Nodes().List(m.ctx, m.listOptions(NodesResource))
+	if err != nil {
+		return nil, err
+	}
+	
+	var resources []K8sResource
+	now := m.now()
+	
+	for _, node := range nodes.Items {
+		age := humanAge(now.Sub(node.CreationTimestamp.Time))
+		
+		// Findings come from the node-* health rules
+		status := "Ready"
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == corev1.NodeReady && condition.Status != corev1.ConditionTrue {
+				status = "NotReady"
+			}
+		}
+		
+		// Get resource usage info
+		allocatable := node.Status.Allocatable
+		cpu := allocatable[corev1.ResourceCPU]
+		memory := allocatable[corev1.ResourceMemory]
+		
+		resource := K8sResource{
+			Name:         node.Name,
+			Namespace:    "", // Nodes are cluster-scoped
+			Status:       status,
+			Age:          age,
+			ResourceType: NodesResource,
+			Object:       node.DeepCopy(),
+			Details: map[string]string{
+				"CPU":            cpu.String(),
+				"Memory":         memory.String(),
+				"OS":             node.Status.NodeInfo.OperatingSystem,
+				"Kernel":         node.Status.NodeInfo.KernelVersion,
+				"Container Runtime": node.Status.NodeInfo.ContainerRuntimeVersion,
+			},
+		}
+		resources = append(resources, resource)
+	}
+	
+	return resources, nil
+}
+
+// Additional placeholder resource loading functions
+func (m Model) loadPods() ([]K8sResource, error) {
+	pods, err := m.clientset.CoreV1().Pods(m.selectedNamespace).List(m.ctx, m.listOptions(PodsResource))
+	if err != nil {
+		return nil, err
+	}
+	
+	var resources []K8sResource
+	now := m.now()
+	
+	for _, pod := range pods.Items {
+		age := humanAge(now.Sub(pod.CreationTimestamp.Time))
+		
+		// Findings come from the pod-* health rules
+		status := string(pod.Status.Phase)
+		restartCount := int32(0)
+		ready := 0
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			restartCount += containerStatus.RestartCount
+			if containerStatus.Ready {
+				ready++
+			}
+		}
+		
+		resource := K8sResource{
+			Name:         pod.Name,
+			Namespace:    pod.Namespace,
+			Status:       status,
+			Age:          age,
+			ResourceType: PodsResource,
+			Object:       pod.DeepCopy(),
+			Details: map[string]string{
+				"Ready":         fmt.Sprintf("%d/%d", ready, len(pod.Spec.Containers)),
+				"Restarts":      strconv.FormatInt(int64(restartCount), 10),
+				"Node":          pod.Spec.NodeName,
+				"Pod IP":        pod.Status.PodIP,
+			},
+		}
+		resources = append(resources, resource)
+	}
+	
+	return resources, nil
+}
+
+// podMetrics mirrors metrics.k8s.io/v1beta1 PodMetrics without pulling in k8s.io/metrics
+type podMetrics struct {
+	Metadata   metav1.ObjectMeta `json:"metadata"`
+	Containers []struct {
+		Name  string              `json:"name"`
+		Usage corev1.ResourceList `json:"usage"`
+	} `json:"containers"`
+}
+
+// nodeMetrics mirrors metrics.k8s.io/v1beta1 NodeMetrics
+type nodeMetrics struct {
+	Metadata metav1.ObjectMeta   `json:"metadata"`
+	Usage    corev1.ResourceList `json:"usage"`
+}
+
+// rawGet reads an API path without a typed client, e.g. metrics.k8s.io
+func (m Model) rawGet(path string) ([]byte, error) {
+	client := m.clientset.Discovery().RESTClient()
+	if client == nil {
+		// Fake clientsets have no REST client
+		return nil, fmt.Errorf("%s is not available from this client", path)
+	}
+	return client.Get().AbsPath(path).DoRaw(m.ctx)
+}
+
+// fetchPodMetrics returns pod usage keyed by namespace/name; an empty namespace means all namespaces
+func (m Model) fetchPodMetrics(namespace string) (map[string]podMetrics, error) {
+	path := "/apis/metrics.k8s.io/v1beta1/pods"
+	if namespace != "" {
+		path = fmt.Sprintf("/apis/metrics.k8s.io/v1beta1/namespaces/%s/pods", namespace)
+	}
+	data, err := m.rawGet(path)
+	if err != nil {
+		return nil, err
+	}
+	
+	var list struct {
+		Items []podMetrics `json:"items"`
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed to decode pod metrics: %v", err)
+	}
+	
+	result := make(map[string]podMetrics, len(list.Items))
+	for _, item := range list.Items {
+		result[item.Metadata.Namespace+"/"+item.Metadata.Name] = item
+	}
+	return result, nil
+}
+
+// fetchNodeMetrics returns node usage keyed by node name
+func (m Model) fetchNodeMetrics() (map[string]nodeMetrics, error) {
+	data, err := m.rawGet("/apis/metrics.k8s.io/v1beta1/nodes")
+	if err != nil {
+		return nil, err
+	}
+	
+	var list struct {
+		Items []nodeMetrics `json:"items"`
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed to decode node metrics: %v", err)
+	}
+	
+	result := make(map[string]nodeMetrics, len(list.Items))
+	for _, item := range list.Items {
+		result[item.Metadata.Name] = item
+	}
+	return result, nil
+}
+
+// attachPodMetrics fills in pod and container usage against requests and limits
+func (m Model) attachPodMetrics(resources []K8sResource) error {
+	metrics, err := m.fetchPodMetrics(m.selectedNamespace)
+	if err != nil {
+		return err
+	}
+	
+	for i := range resources {
+		res := &resources[i]
+		pod, ok := res.Object.(*corev1.Pod)
+		if !ok {
+			continue
+		}
+		pm, ok := metrics[pod.Namespace+"/"+pod.Name]
+		if !ok {
+			continue
+		}
+		
+		specs := map[string]corev1.ResourceRequirements{}
+		for _, container := range pod.Spec.Containers {
+			specs[container.Name] = container.Resources
+		}
+		
+		usage := &UsageMetrics{}
+		var cpuReq, memReq, cpuLim, memLim resource.Quantity
+		// A share of limits is only meaningful when every container has one
+		cpuLimitsComplete, memLimitsComplete := true, true
+		for _, cm := range pm.Containers {
+			spec := specs[cm.Name]
+			cu := UsageMetrics{
+				Name:           cm.Name,
+				CPU:            cm.Usage[corev1.ResourceCPU],
+				Memory:         cm.Usage[corev1.ResourceMemory],
+				CPUPercent:     usagePercent(cm.Usage[corev1.ResourceCPU], spec.Requests[corev1.ResourceCPU]),
+				MemoryPercent:  usagePercent(cm.Usage[corev1.ResourceMemory], spec.Requests[corev1.ResourceMemory]),
+				CPULimitPct:    usagePercent(cm.Usage[corev1.ResourceCPU], spec.Limits[corev1.ResourceCPU]),
+				MemoryLimitPct: usagePercent(cm.Usage[corev1.ResourceMemory], spec.Limits[corev1.ResourceMemory]),
+			}
+			usage.Containers = append(usage.Containers, cu)
+			usage.CPU.Add(cu.CPU)
+			usage.Memory.Add(cu.Memory)
+			cpuReq.Add(spec.Requests[corev1.ResourceCPU])
+			memReq.Add(spec.Requests[corev1.ResourceMemory])
+			cpuLim.Add(spec.Limits[corev1.ResourceCPU])
+			memLim.Add(spec.Limits[corev1.ResourceMemory])
+			if _, ok := spec.Limits[corev1.ResourceCPU]; !ok {
+				cpuLimitsComplete = false
+			}
+			if _, ok := spec.Limits[corev1.ResourceMemory]; !ok {
+				memLimitsComplete = false
+			}
+		}
+		usage.CPUPercent = usagePercent(usage.CPU, cpuReq)
+		usage.MemoryPercent = usagePercent(usage.Memory, memReq)
+		usage.CPULimitPct, usage.MemoryLimitPct = -1, -1
+		if cpuLimitsComplete {
+			usage.CPULimitPct = usagePercent(usage.CPU, cpuLim)
+		}
+		if memLimitsComplete {
+			usage.MemoryLimitPct = usagePercent(usage.Memory, memLim)
+		}
+		
+		res.Usage = usage
+		res.Details["CPU Usage"] = formatUsage(formatCPU(usage.CPU), usage.CPUPercent, "req")
+		res.Details["Memory Usage"] = formatUsage(formatBytes(usage.Memory), usage.MemoryPercent, "req")
+	}
+	// The usage rules can only fire now that usage is known
+	m.rules.apply(resources, m.now())
+	return nil
+}
+
+// attachNodeMetrics fills in node usage as a share of allocatable capacity
+func (m Model) attachNodeMetrics(resources []K8sResource) error {
+	metrics, err := m.fetchNodeMetrics()
+	if err != nil {
+		return err
+	}
+	
+	for i := range resources {
+		res := &resources[i]
+		node, ok := res.Object.(*corev1.Node)
+		if !ok {
+			continue
+		}
+		nm, ok := metrics[node.Name]
+		if !ok {
+			continue
+		}
+		
+		usage := &UsageMetrics{
+			CPU:            nm.Usage[corev1.ResourceCPU],
+			Memory:         nm.Usage[corev1.ResourceMemory],
+			CPUPercent:     usagePercent(nm.Usage[corev1.ResourceCPU], node.Status.Allocatable[corev1.ResourceCPU]),
+			MemoryPercent:  usagePercent(nm.Usage[corev1.ResourceMemory], node.Status.Allocatable[corev1.ResourceMemory]),
+			CPULimitPct:    -1,
+			MemoryLimitPct: -1,
+		}
+		res.Usage = usage
+		res.Details["CPU Usage"] = formatUsage(formatCPU(usage.CPU), usage.CPUPercent, "allocatable")
+		res.Details["Memory Usage"] = formatUsage(formatBytes(usage.Memory), usage.MemoryPercent, "allocatable")
+	}
+	m.rules.apply(resources, m.now())
+	return nil
+}
+
+// describeMetricsError turns a metrics.k8s.io failure into a short indicator message
+func describeMetricsError(err error) string {
+	switch {
+	case err == nil:
+		return ""
+	case apierrors.IsNotFound(err):
+		return "metrics-server is not installed (metrics.k8s.io not found)"
+	case apierrors.IsServiceUnavailable(err):
+		return "metrics-server is not responding"
+	case apierrors.IsForbidden(err):
+		return "no permission to read metrics.k8s.io"
+	default:
+		return fmt.Sprintf("metrics unavailable: %v", err)
+	}
+}
+
+// usagePercent returns usage as a percentage of base, or -1 when base is not set
+func usagePercent(usage, base resource.Quantity) int {
+	if base.IsZero() {
+		return -1
+	}
+	return int(usage.MilliValue() * 100 / base.MilliValue())
+}
+
+// usageLevelColor picks the threshold color for a usage percentage
+func usageLevelColor(pct int) lipgloss.Color {
+	switch {
+	case pct >= 90:
+		return colors.Error
+	case pct >= 75:
+		return colors.Warning
+	case pct >= 0:
+		return colors.Success
+	default:
+		return colors.Text
+	}
+}
+
+func formatCPU(q resource.Quantity) string {
+	return fmt.Sprintf("%dm", q.MilliValue())
+}
+
+func formatBytes(q resource.Quantity) string {
+	bytes := q.Value()
+	switch {
+	case bytes >= 1<<30:
+		return fmt.Sprintf("%.1fGi", float64(bytes)/(1<<30))
+	case bytes >= 1<<20:
+		return fmt.Sprintf("%dMi", bytes>>20)
+	case bytes >= 1<<10:
+		return fmt.Sprintf("%dKi", bytes>>10)
+	default:
+		return fmt.Sprintf("%d", bytes)
+	}
+}
+
+func formatUsage(value string, pct int, of string) string {
+	if pct < 0 {
+		return value
+	}
+	return fmt.Sprintf("%s (%d%% of %s)", value, pct, of)
+}
+
+// metricHistoryLimit is how many refresh samples are kept per resource (10 minutes at the 5s refresh)
+const metricHistoryLimit = 120
+
+// MetricSeries is the rolling in-session history of one pod or node
+type MetricSeries struct {
+	Times    []time.Time
+	CPU      []float64 // Millicores, -1 when metrics were unavailable for the sample
+	Memory   []float64 // Bytes, -1 when metrics were unavailable for the sample
+	Restarts []float64 // Total container restarts (pods only)
+}
+
+// add appends a sample and drops the oldest once the series is full
+func (s *MetricSeries) add(t time.Time, cpu, memory, restarts float64) {
+	s.Times = append(s.Times, t)
+	s.CPU = append(s.CPU, cpu)
+	s.Memory = append(s.Memory, memory)
+	s.Restarts = append(s.Restarts, restarts)
+	if over := len(s.Times) - metricHistoryLimit; over > 0 {
+		s.Times = s.Times[over:]
+		s.CPU = s.CPU[over:]
+		s.Memory = s.Memory[over:]
+		s.Restarts = s.Restarts[over:]
+	}
+}
+
+// metricHistoryKey identifies a resource across refreshes
+func metricHistoryKey(res K8sResource) string {
+	return fmt.Sprintf("%d/%s/%s", res.ResourceType, res.Namespace, res.Name)
+}
+
+// recordMetricHistory appends the latest usage and restart counts of pods and nodes
+func (m Model) recordMetricHistory(resources []K8sResource) {
+	if m.metricHistory == nil {
+		return
+	}
+	now := time.Now()
+	for _, res := range resources {
+		if res.ResourceType != PodsResource && res.ResourceType != NodesResource {
+			continue
+		}
+		cpu, memory := -1.0, -1.0
+		if res.Usage != nil {
+			cpu = float64(res.Usage.CPU.MilliValue())
+			memory = float64(res.Usage.Memory.Value())
+		}
+		restarts, _ := strconv.Atoi(res.Details["Restarts"])
+		
+		key := metricHistoryKey(res)
+		series, ok := m.metricHistory[key]
+		if !ok {
+			series = &MetricSeries{}
+			m.metricHistory[key] = series
+		}
+		series.add(now, cpu, memory, float64(restarts))
+	}
+}
+
+// sparklineTicks are the block characters used to draw sparklines, lowest first
+var sparklineTicks = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline draws the last width values scaled between their min and max; missing samples are blank
+func sparkline(values []float64, width int) string {
+	if len(values) > width {
+		values = values[len(values)-width:]
+	}
+	low, high := -1.0, -1.0
+	for _, v := range values {
+		if v < 0 {
+			continue
+		}
+		if low < 0 || v < low {
+			low = v
+		}
+		if v > high {
+			high = v
+		}
+	}
+	
+	var b strings.Builder
+	for _, v := range values {
+		switch {
+		case v < 0:
+			b.WriteRune(' ')
+		case high == low:
+			// A flat line sits at the bottom unless everything is non-zero
+			if v == 0 {
+				b.WriteRune(sparklineTicks[0])
+			} else {
+				b.WriteRune(sparklineTicks[len(sparklineTicks)/2])
+			}
+		default:
+			idx := int((v - low) / (high - low) * float64(len(sparklineTicks)-1))
+			b.WriteRune(sparklineTicks[idx])
+		}
+	}
+	return b.String()
+}
+
+// lastSample returns the most recent value that was actually measured
+func lastSample(values []float64) (float64, bool) {
+	for i := len(values) - 1; i >= 0; i-- {
+		if values[i] >= 0 {
+			return values[i], true
+		}
+	}
+	return 0, false
+}
+
+// maxSample returns the largest measured value
+func maxSample(values []float64) float64 {
+	high := 0.0
+	for _, v := range values {
+		if v > high {
+			high = v
+		}
+	}
+	return high
+}
+
+// renderMetricHistory renders CPU, memory and restart sparklines for a resource
+func (m Model) renderMetricHistory(res K8sResource, width int, indent string) string {
+	series, ok := m.metricHistory[metricHistoryKey(res)]
+	if !ok || len(series.Times) == 0 {
+		return lipgloss.NewStyle().Foreground(colors.Muted).Italic(true).Render(indent+"No history recorded yet") + "\n"
+	}
+	
+	labelStyle := lipgloss.NewStyle().Foreground(colors.Info)
+	lineStyle := lipgloss.NewStyle().Foreground(colors.Secondary)
+	
+	var content strings.Builder
+	row := func(label string, values []float64, format func(float64) string) {
+		current := "n/a"
+		if v, ok := lastSample(values); ok {
+			current = format(v)
+		}
+		content.WriteString(fmt.Sprintf("%s%s %s %s\n",
+			indent,
+			labelStyle.Render(fmt.Sprintf("%-8s", label)),
+			lineStyle.Render(fmt.Sprintf("%-*s", width, sparkline(values, width))),
+			fmt.Sprintf("%s (max %s)", current, format(maxSample(values)))))
+	}
+	
+	row("CPU", series.CPU, func(v float64) string { return fmt.Sprintf("%.0fm", v) })
+	row("Memory", series.Memory, func(v float64) string {
+		return formatBytes(*resource.NewQuantity(int64(v), resource.BinarySI))
+	})
+	if res.ResourceType == PodsResource {
+		row("Restarts", series.Restarts, func(v float64) string { return fmt.Sprintf("%.0f", v) })
+		
+		// A restart during the session is exactly what this view is meant to catch
+		if first, last := series.Restarts[0], series.Restarts[len(series.Restarts)-1]; last > first {
+			content.WriteString(lipgloss.NewStyle().Foreground(colors.Warning).Render(
+				fmt.Sprintf("%s⚠️  %.0f restart(s) during this session", indent, last-first)) + "\n")
+		}
+	}
+	content.WriteString(lipgloss.NewStyle().Foreground(colors.Muted).Italic(true).Render(
+		fmt.Sprintf("%s%d samples over %s", indent, len(series.Times), humanAge(time.Since(series.Times[0])))) + "\n")
+	
+	return content.String()
+}
+
+// Add more resource loading functions as needed...
+func (m Model) loadPersistentVolumes() ([]K8sResource, error) { return []K8sResource{}, nil }
+func (m Model) loadStorageClasses() ([]K8sResource, error) { return []K8sResource{}, nil }
+func (m Model) loadClusterRoles() ([]K8sResource, error) { return []K8sResource{}, nil }
+func (m Model) loadServices() ([]K8sResource, error) {
+	services, err := m.clientset.CoreV1().Services(m.selectedNamespace).List(m.ctx, m.listOptions(ServicesResource))
+	if err != nil {
+		return nil, err
+	}
+	
+	// Count ready endpoints per service from its EndpointSlices
+	readyEndpoints := map[string]int{}
+	totalEndpoints := map[string]int{}
+	slices, sliceErr := m.clientset.DiscoveryV1().EndpointSlices(m.selectedNamespace).List(m.ctx, metav1.ListOptions{})
+	if sliceErr == nil {
+		for _, slice := range slices.Items {
+			key := slice.Namespace + "/" + slice.Labels[discoveryv1.LabelServiceName]
+			for _, ep := range slice.Endpoints {
+				totalEndpoints[key] += len(ep.Addresses)
+				if ep.Conditions.Ready == nil || *ep.Conditions.Ready {
+					readyEndpoints[key] += len(ep.Addresses)
+				}
+			}
+		}
+	}
+	
+	var resources []K8sResource
+	now := m.now()
+	
+	for _, service := range services.Items {
+		age := humanAge(now.Sub(service.CreationTimestamp.Time))
+		
+		status := "Active"
+		clusterIP := service.Spec.ClusterIP
+		if clusterIP == "" {
+			clusterIP = "None"
+		}
+		
+		var ruleData map[string]interface{}
+		details := map[string]string{
+			"Type":       string(service.Spec.Type),
+			"Cluster-IP": clusterIP,
+			"Ports":      servicePortsString(service.Spec.Ports),
+			"Selector":   fmt.Sprintf("%v", service.Spec.Selector),
+		}
+		if sliceErr == nil && service.Spec.Type != corev1.ServiceTypeExternalName {
+			key := service.Namespace + "/" + service.Name
+			details["Endpoints"] = fmt.Sprintf("%d/%d ready", readyEndpoints[key], totalEndpoints[key])
+			ruleData = map[string]interface{}{"endpoints": map[string]interface{}{
+				"ready": int64(readyEndpoints[key]), "total": int64(totalEndpoints[key])}}
+		}
+		
+		resource := K8sResource{
+			Name:         service.Name,
+			Namespace:    service.Namespace,
+			Status:       status,
+			Age:          age,
+			ResourceType: ServicesResource,
+			Object:       service.DeepCopy(),
+			RuleData:     ruleData,
+			Details:      details,
+		}
+		resources = append(resources, resource)
+	}
+	
+	return resources, nil
+}
+
+// servicePortsString formats service ports as port[:nodePort]/protocol
+func servicePortsString(servicePorts []corev1.ServicePort) string {
+	var ports []string
+	for _, port := range servicePorts {
+		if port.NodePort != 0 {
+			ports = append(ports, fmt.Sprintf("%d:%d/%s", port.Port, port.NodePort, port.Protocol))
+		} else {
+			ports = append(ports, fmt.Sprintf("%d/%s", port.Port, port.Protocol))
+		}
+	}
+	if len(ports) == 0 {
+		return "None"
+	}
+	return strings.Join(ports, ",")
+}
+
+func (m Model) loadDeployments() ([]K8sResource, error) {
+	deployments, err := m.clientset.AppsV1().Deployments(m.selectedNamespace).List(m.ctx, m.listOptions(DeploymentsResource))
+	if err != nil {
+		return nil, err
+	}
+	
+	var resources []K8sResource
+	now := m.now()
+	
+	for _, deployment := range deployments.Items {
+		age := humanAge(now.Sub(deployment.CreationTimestamp.Time))
+		
+		status := "Available"
+		for _, condition := range deployment.Status.Conditions {
+			if condition.Type == appsv1.DeploymentAvailable && condition.Status != corev1.ConditionTrue {
+				status = "NotAvailable"
+			}
+		}
+		ready := fmt.Sprintf("%d/%d", deployment.Status.ReadyReplicas, deployment.Status.Replicas)
+		if deployment.Status.ReadyReplicas != deployment.Status.Replicas {
+			status = "NotReady"
+		}
+		
+		resource := K8sResource{
+			Name:         deployment.Name,
+			Namespace:    deployment.Namespace,
+			Status:       status,
+			Age:          age,
+			ResourceType: DeploymentsResource,
+			Object:       deployment.DeepCopy(),
+			Details: map[string]string{
+				"Ready":           ready,
+				"Up-to-date":      fmt.Sprintf("%d", deployment.Status.UpdatedReplicas),
+				"Available":       fmt.Sprintf("%d", deployment.Status.AvailableReplicas),
+				"Strategy":        string(deployment.Spec.Strategy.Type),
+			},
+		}
+		resources = append(resources, resource)
+	}
+	
+	return resources, nil
+}
+func (m Model) loadConfigMaps() ([]K8sResource, error) { return []K8sResource{}, nil }
+func (m Model) loadSecrets() ([]K8sResource, error) { return []K8sResource{}, nil }
+func (m Model) loadIngress() ([]K8sResource, error) {
+	ingresses, err := m.clientset.NetworkingV1().Ingresses(m.selectedNamespace).List(m.ctx, m.listOptions(IngressResource))
+	if err != nil {
+		return nil, err
+	}
+	
+	var resources []K8sResource
+	now := m.now()
+	
+	for _, ing := range ingresses.Items {
+		age := humanAge(now.Sub(ing.CreationTimestamp.Time))
+		
+		var hosts []string
+		for _, rule := range ing.Spec.Rules {
+			if rule.Host != "" {
+				hosts = append(hosts, rule.Host)
+			}
+		}
+		
+		var addresses []string
+		for _, lb := range ing.Status.LoadBalancer.Ingress {
+			if lb.IP != "" {
+				addresses = append(addresses, lb.IP)
+			} else if lb.Hostname != "" {
+				addresses = append(addresses, lb.Hostname)
+			}
+		}
+		
+		status := "Active"
+		if len(addresses) == 0 {
+			status = "Pending"
+		}
+		
+		resource := K8sResource{
+			Name:         ing.Name,
+			Namespace:    ing.Namespace,
+			Status:       status,
+			Age:          age,
+			ResourceType: IngressResource,
+			Object:       ing.DeepCopy(),
+			Details: map[string]string{
+				"Hosts":   strings.Join(hosts, ","),
+				"Rules":   fmt.Sprintf("%d", len(ing.Spec.Rules)),
+				"Class":   stringPtrValue(ing.Spec.IngressClassName),
+				"Address": strings.Join(addresses, ","),
+			},
+		}
+		resources = append(resources, resource)
+	}
+	
+	return resources, nil
+}
+func (m Model) loadEventsResource() ([]K8sResource, error) { return []K8sResource{}, nil }
+
+func (m Model) loadPersistentVolumeClaims() ([]K8sResource, error) {
+	pvcs, err := m.clientset.CoreV1().PersistentVolumeClaims(m.selectedNamespace).List(m.ctx, m.listOptions(PersistentVolumeClaimsResource))
+	if err != nil {
+		return nil, err
+	}
+	
+	var resources []K8sResource
+	now := m.now()
+	
+	for _, pvc := range pvcs.Items {
+		age := humanAge(now.Sub(pvc.CreationTimestamp.Time))
+		
+		capacity := resource.Quantity{}
+		if pvc.Status.Capacity != nil {
+			capacity = pvc.Status.Capacity[corev1.ResourceStorage]
+		}
+		
+		resource := K8sResource{
+			Name:         pvc.Name,
+			Namespace:    pvc.Namespace,
+			Status:       string(pvc.Status.Phase),
+			Age:          age,
+			ResourceType: PersistentVolumeClaimsResource,
+			Object:       pvc.DeepCopy(),
+			Details: map[string]string{
+				"Capacity":     capacity.String(),
+				"AccessModes":  strings.Join(accessModesToStrings(pvc.Spec.AccessModes), ","),
+				"StorageClass": stringPtrValue(pvc.Spec.StorageClassName),
+				"Volume":       pvc.Spec.VolumeName,
+			},
+		}
+		resources = append(resources, resource)
+	}
+	
+	return resources, nil
+}
+
+func (m Model) loadReplicaSets() ([]K8sResource, error) {
+	rss, err := m.clientset.AppsV1().ReplicaSets(m.selectedNamespace).List(m.ctx, m.listOptions(ReplicaSetsResource))
+	if err != nil {
+		return nil, err
+	}
+	
+	var resources []K8sResource
+	now := m.now()
+	
+	for _, rs := range rss.Items {
+		age := humanAge(now.Sub(rs.CreationTimestamp.Time))
+		
+		ready := fmt.Sprintf("%d/%d", rs.Status.ReadyReplicas, rs.Status.Replicas)
+		status := "Running"
+		if rs.Status.ReadyReplicas != rs.Status.Replicas {
+			status = "NotReady"
+		}
+		
+		resource := K8sResource{
+			Name:         rs.Name,
+			Namespace:    rs.Namespace,
+			Status:       status,
+			Age:          age,
+			ResourceType: ReplicaSetsResource,
+			Object:       rs.DeepCopy(),
+			Details: map[string]string{
+				"Ready":     ready,
+				"Available": fmt.Sprintf("%d", rs.Status.AvailableReplicas),
+			},
+		}
+		resources = append(resources, resource)
+	}
+	
+	return resources, nil
+}
+
+func (m Model) loadDaemonSets() ([]K8sResource, error) {
+	dss, err := m.clientset.AppsV1().DaemonSets(m.selectedNamespace).List(m.ctx, m.listOptions(DaemonSetsResource))
+	if err != nil {
+		return nil, err
+	}
+	
+	var resources []K8sResource
+	now := m.now()
+	
+	for _, ds := range dss.Items {
+		age := humanAge(now.Sub(ds.CreationTimestamp.Time))
+		
+		desired := ds.Status.DesiredNumberScheduled
+		ready := ds.Status.NumberReady
+		status := "Running"
+		if ready != desired {
+			status = "NotReady"
+		}
+		
+		resource := K8sResource{
+			Name:         ds.Name,
+			Namespace:    ds.Namespace,
+			Status:       status,
+			Age:          age,
+			ResourceType: DaemonSetsResource,
+			Object:       ds.DeepCopy(),
+			Details: map[string]string{
+				"Desired": fmt.Sprintf("%d", desired),
+				"Ready":   fmt.Sprintf("%d", ready),
+			},
+		}
+		resources = append(resources, resource)
+	}
+	
+	return resources, nil
+}
+
+func (m Model) loadStatefulSets() ([]K8sResource, error) {
+	sss, err := m.clientset.AppsV1().StatefulSets(m.selectedNamespace).List(m.ctx, m.listOptions(StatefulSetsResource))
+	if err != nil {
+		return nil, err
+	}
+	
+	var resources []K8sResource
+	now := m.now()
+	
+	for _, ss := range sss.Items {
+		age := humanAge(now.Sub(ss.CreationTimestamp.Time))
+		
+		ready := fmt.Sprintf("%d/%d", ss.Status.ReadyReplicas, ss.Status.Replicas)
+		status := "Running"
+		if ss.Status.ReadyReplicas != ss.Status.Replicas {
+			status = "NotReady"
+		}
+		
+		resource := K8sResource{
+			Name:         ss.Name,
+			Namespace:    ss.Namespace,
+			Status:       status,
+			Age:          age,
+			ResourceType: StatefulSetsResource,
+			Object:       ss.DeepCopy(),
+			Details: map[string]string{
+				"Ready":   ready,
+				"Service": ss.Spec.ServiceName,
+			},
+		}
+		resources = append(resources, resource)
+	}
+	
+	return resources, nil
+}
+
+func (m Model) loadJobs() ([]K8sResource, error) {
+	jobs, err := m.clientset.BatchV1().Jobs(m.selectedNamespace).List(m.ctx, m.listOptions(JobsResource))
+	if err != nil {
+		return nil, err
+	}
+	
+	var resources []K8sResource
+	now := m.now()
+	
+	for _, job := range jobs.Items {
+		resources = append(resources, jobToResource(&job, now))
+	}
+	
+	return resources, nil
+}
+
+// jobToResource converts a Job into a K8sResource with its completion status; the job-* rules report failures
+func jobToResource(job *batchv1.Job, now time.Time) K8sResource {
+	age := humanAge(now.Sub(job.CreationTimestamp.Time))
+	
+	wanted := int32(1)
+	if job.Spec.Completions != nil {
+		wanted = *job.Spec.Completions
+	}
+	
+	status := "Running"
+	if job.Status.Succeeded >= wanted {
+		status = "Complete"
+	} else if job.Status.Failed > 0 {
+		status = "Failed"
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			status = "Failed"
+		}
+		if condition.Type == batchv1.JobSuspended && condition.Status == corev1.ConditionTrue {
+			status = "Suspended"
+		}
+	}
+	
+	owner := ""
+	for _, ref := range job.OwnerReferences {
+		if ref.Kind == "CronJob" {
+			owner = ref.Name
+		}
+	}
+	
+	return K8sResource{
+		Name:         job.Name,
+		Namespace:    job.Namespace,
+		Status:       status,
+		Age:          age,
+		ResourceType: JobsResource,
+		Object:       job.DeepCopy(),
+		Details: map[string]string{
+			"Completions": fmt.Sprintf("%d/%d", job.Status.Succeeded, wanted),
+			"Failed":      fmt.Sprintf("%d", job.Status.Failed),
+			"Active":      fmt.Sprintf("%d", job.Status.Active),
+			"Duration":    formatDuration(job.Status.StartTime, job.Status.CompletionTime),
+			"CronJob":     owner,
+		},
+	}
+}
+
+func (m Model) loadCronJobs() ([]K8sResource, error) {
+	cronJobs, err := m.clientset.BatchV1().CronJobs(m.selectedNamespace).List(m.ctx, m.listOptions(CronJobsResource))
+	if err != nil {
+		return nil, err
+	}
+	
+	var resources []K8sResource
+	now := m.now()
+	
+	for _, cj := range cronJobs.Items {
+		age := humanAge(now.Sub(cj.CreationTimestamp.Time))
+		
+		status := "Active"
+		suspended := cj.Spec.Suspend != nil && *cj.Spec.Suspend
+		if suspended {
+			status = "Suspended"
+		}
+		
+		lastSchedule := "Never"
+		if cj.Status.LastScheduleTime != nil {
+			lastSchedule = humanAge(now.Sub(cj.Status.LastScheduleTime.Time)) + " ago"
+		}
+		lastSuccess := "Never"
+		if cj.Status.LastSuccessfulTime != nil {
+			lastSuccess = humanAge(now.Sub(cj.Status.LastSuccessfulTime.Time)) + " ago"
+		}
+		
+		var ruleData map[string]interface{}
+		
+		// Without a timeZone the controller uses kube-controller-manager's local time zone, which
+		// cannot be read through the API; most control planes run in UTC, so assume that
+		timeZone, zoneLabel := "UTC", "UTC (assumed)"
+		if cj.Spec.TimeZone != nil && *cj.Spec.TimeZone != "" {
+			timeZone = *cj.Spec.TimeZone
+			zoneLabel = timeZone
+		}
+		nextRun := "-"
+		if suspended {
+			nextRun = "Suspended"
+		} else if next, err := nextCronRun(cj.Spec.Schedule, timeZone, now); err != nil {
+			ruleData = map[string]interface{}{"nextRun": map[string]interface{}{"error": err.Error()}}
+		} else if next.IsZero() {
+			nextRun = "Never"
+		} else {
+			nextRun = fmt.Sprintf("%s (in %s)", next.Format("2006-01-02 15:04 MST"), humanAge(next.Sub(now)))
+		}
+		
+		resource := K8sResource{
+			Name:         cj.Name,
+			Namespace:    cj.Namespace,
+			Status:       status,
+			Age:          age,
+			ResourceType: CronJobsResource,
+			Object:       cj.DeepCopy(),
+			RuleData:     ruleData,
+			Details: map[string]string{
+				"Schedule":     cj.Spec.Schedule,
+				"TimeZone":     zoneLabel,
+				"LastSchedule": lastSchedule,
+				"LastSuccess":  lastSuccess,
+				"NextRun":      nextRun,
+				"Active":       fmt.Sprintf("%d", len(cj.Status.Active)),
+			},
+		}
+		resources = append(resources, resource)
+	}
+	
+	return resources, nil
+}
+
+// triggerCronJob creates a Job from the CronJob's template, like `kubectl create job --from=cronjob/...`
+func (m Model) triggerCronJob(namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		cj, err := m.clientset.BatchV1().CronJobs(namespace).Get(m.ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return cronJobActionMsg{err: fmt.Errorf("failed to get CronJob %s: %v", name, err)}
+		}
+		
+		// Job names are limited to 63 characters; leave room for the suffix
+		prefix := cj.Name
+		if len(prefix) > 45 {
+			prefix = prefix[:45]
+		}
+		
+		annotations := map[string]string{"cronjob.kubernetes.io/instantiate": "manual"}
+		for k, v := range cj.Spec.JobTemplate.Annotations {
+			annotations[k] = v
+		}
+		labels := map[string]string{}
+		for k, v := range cj.Spec.JobTemplate.Labels {
+			labels[k] = v
+		}
+		
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            fmt.Sprintf("%s-manual-%d", prefix, time.Now().Unix()),
+				Namespace:       cj.Namespace,
+				Labels:          labels,
+				Annotations:     annotations,
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(cj, batchv1.SchemeGroupVersion.WithKind("CronJob"))},
+			},
+			Spec: cj.Spec.JobTemplate.Spec,
+		}
+		
+		created, err := m.clientset.BatchV1().Jobs(namespace).Create(m.ctx, job, metav1.CreateOptions{})
+		if err != nil {
+			return cronJobActionMsg{err: fmt.Errorf("failed to trigger CronJob %s: %v", name, err)}
+		}
+		
+		return cronJobActionMsg{message: fmt.Sprintf("Created Job %s from CronJob %s", created.Name, name)}
+	}
+}
+
+// setCronJobSuspend patches spec.suspend on a CronJob
+func (m Model) setCronJobSuspend(namespace, name string, suspend bool) tea.Cmd {
+	return func() tea.Msg {
+		patch := []byte(fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspend))
+		_, err := m.clientset.BatchV1().CronJobs(namespace).Patch(m.ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+		if err != nil {
+			return cronJobActionMsg{err: fmt.Errorf("failed to update CronJob %s: %v", name, err)}
+		}
+		
+		action := "Resumed"
+		if suspend {
+			action = "Suspended"
+		}
+		return cronJobActionMsg{message: fmt.Sprintf("%s CronJob %s", action, name)}
+	}
+}
+
+// loadCronJobHistory lists the Jobs owned by the history CronJob (newest first), each followed by its pods
+func (m Model) loadCronJobHistory() tea.Cmd {
+	return func() tea.Msg {
+		if m.historyCronJob == nil {
+			return cronJobHistoryLoadedMsg{err: fmt.Errorf("no CronJob selected")}
+		}
+		namespace := m.historyCronJob.Namespace
+		
+		cj, err := m.clientset.BatchV1().CronJobs(namespace).Get(m.ctx, m.historyCronJob.Name, metav1.GetOptions{})
+		if err != nil {
+			return cronJobHistoryLoadedMsg{err: err}
+		}
+		jobs, err := m.clientset.BatchV1().Jobs(namespace).List(m.ctx, metav1.ListOptions{})
+		if err != nil {
+			return cronJobHistoryLoadedMsg{err: err}
+		}
+		pods, err := m.clientset.CoreV1().Pods(namespace).List(m.ctx, metav1.ListOptions{})
+		if err != nil {
+			return cronJobHistoryLoadedMsg{err: err}
+		}
+		
+		// Index pods by the UID of the Job that owns them
+		podsByJob := map[types.UID][]corev1.Pod{}
+		for _, pod := range pods.Items {
+			for _, ref := range pod.OwnerReferences {
+				if ref.Kind == "Job" {
+					podsByJob[ref.UID] = append(podsByJob[ref.UID], pod)
+				}
+			}
+		}
+		
+		var owned []batchv1.Job
+		for _, job := range jobs.Items {
+			for _, ref := range job.OwnerReferences {
+				if ref.UID == cj.UID {
+					owned = append(owned, job)
+					break
+				}
+			}
+		}
+		sort.Slice(owned, func(i, j int) bool {
+			return owned[j].CreationTimestamp.Before(&owned[i].CreationTimestamp)
+		})
+		
+		now := m.now()
+		var entries []K8sResource
+		for _, job := range owned {
+			entries = append(entries, jobToResource(&job, now))
+			
+			jobPods := podsByJob[job.UID]
+			sort.Slice(jobPods, func(i, j int) bool {
+				return jobPods[i].CreationTimestamp.Before(&jobPods[j].CreationTimestamp)
+			})
+			for _, pod := range jobPods {
+				restarts := int32(0)
+				for _, cs := range pod.Status.ContainerStatuses {
+					restarts += cs.RestartCount
+				}
+				entries = append(entries, K8sResource{
+					Name:         pod.Name,
+					Namespace:    pod.Namespace,
+					Status:       string(pod.Status.Phase),
+					Age:          humanAge(now.Sub(pod.CreationTimestamp.Time)),
+					ResourceType: PodsResource,
+					Object:       pod.DeepCopy(),
+					Details: map[string]string{
+						"Job":      job.Name,
+						"Node":     pod.Spec.NodeName,
+						"Restarts": fmt.Sprintf("%d", restarts),
+					},
+				})
+			}
+		}
+		
+		m.rules.apply(entries, now)
+		return cronJobHistoryLoadedMsg{entries: entries}
+	}
+}
+
+// renderCronJobHistory renders the Jobs of a CronJob with their pods indented beneath them
+func (m Model) renderCronJobHistory() string {
+	var content strings.Builder
+	headerStyle := lipgloss.NewStyle().Foreground(colors.Success).Bold(true)
+	
+	name := ""
+	if m.historyCronJob != nil {
+		name = m.historyCronJob.Name
+		if schedule := m.historyCronJob.Details["Schedule"]; schedule != "" {
+			name += fmt.Sprintf(" (%s, next: %s)", schedule, m.historyCronJob.Details["NextRun"])
+		}
+	}
+	content.WriteString(headerStyle.Render(fmt.Sprintf("🕘 Run history for CronJob %s", name)) + "\n\n")
+	
+	if len(m.historyEntries) == 0 {
+		if !m.loading {
+			content.WriteString("No Jobs found for this CronJob\n")
+		}
+		return content.String()
+	}
+	
+	normalStyle := lipgloss.NewStyle().Foreground(colors.Text)
+	selectedStyle := colors.selection(colors.Secondary).Padding(0, 1)
+	errorStyle := lipgloss.NewStyle().Foreground(colors.Error)
+	successStyle := lipgloss.NewStyle().Foreground(colors.Success)
+	
+	content.WriteString(normalStyle.Render(fmt.Sprintf("%-45s %-12s %-12s %-8s", "JOB / POD", "STATUS", "COMPLETIONS", "AGE")) + "\n")
+	dividerWidth := m.width
+	if dividerWidth == 0 {
+		dividerWidth = 70 // fallback width
+	}
+	content.WriteString(lipgloss.NewStyle().Foreground(colors.Muted).Render(strings.Repeat("─", dividerWidth)) + "\n")
+	
+	matches := m.filterMatches()
+	for i, entry := range m.historyEntries {
+		if matches != nil && !matches[i] {
+			continue
+		}
+		var row string
+		if entry.ResourceType == JobsResource {
+			row = fmt.Sprintf("%-45s %-12s %-12s %-8s",
+				truncateString(entry.Name, 45), entry.Status, entry.Details["Completions"], entry.Age)
+		} else {
+			row = fmt.Sprintf("%-45s %-12s %-12s %-8s",
+				"  └─ "+truncateString(entry.Name, 40), entry.Status, "", entry.Age)
+		}
+		
+		style := normalStyle
+		if i == m.cursor {
+			style = selectedStyle
+		} else if len(entry.Errors) > 0 || entry.Status == "Failed" {
+			style = errorStyle
+		} else if entry.Status == "Complete" || entry.Status == "Succeeded" {
+			style = successStyle
+		}
+		content.WriteString(m.highlightMatches(row, style) + "\n")
+		content.WriteString(m.filterDetailHint(entry))
+		
+		if i == m.cursor {
+			for _, err := range entry.Errors {
+				content.WriteString(errorStyle.Render("  ❌ "+err) + "\n")
+			}
+			if entry.ResourceType == JobsResource {
+				content.WriteString(lipgloss.NewStyle().Foreground(colors.Info).Render(
+					fmt.Sprintf("  📝 Duration: %s, Failed: %s", entry.Details["Duration"], entry.Details["Failed"])) + "\n")
+			} else {
+				content.WriteString(lipgloss.NewStyle().Foreground(colors.Info).Render(
+					fmt.Sprintf("  📝 Node: %s, Restarts: %s", entry.Details["Node"], entry.Details["Restarts"])) + "\n")
+			}
+		}
+	}
+	
+	return content.String()
+}
+
+// ownerNode is one object in an ownership tree built from ownerReferences
+type ownerNode struct {
+	resource K8sResource
+	uid      types.UID
+	parent   *ownerNode
+	children []*ownerNode
+}
+
+// OwnerTreeRow is a flattened, renderable line of the ownership tree
+type OwnerTreeRow struct {
+	Resource       K8sResource
+	Depth          int
+	Prefix         string // Box-drawing prefix for the tree branches
+	RolledErrors   int    // Errors of this object and everything it owns
+	RolledWarnings int    // Warnings of this object and everything it owns
+}
+
+// supportsOwnershipTree reports whether a resource type takes part in ownership trees
+func supportsOwnershipTree(rt ResourceType) bool {
+	switch rt {
+	case PodsResource, DeploymentsResource, ReplicaSetsResource, StatefulSetsResource,
+		DaemonSetsResource, JobsResource, CronJobsResource, PersistentVolumeClaimsResource:
+		return true
+	}
+	return false
+}
+
+// resourceKinds maps resource types to their API kind
+var resourceKinds = map[ResourceType]string{
+	NodesResource:                    "Node",
+	PersistentVolumesResource:        "PersistentVolume",
+	StorageClassesResource:           "StorageClass",
+	ClusterRolesResource:             "ClusterRole",
+	PodsResource:                     "Pod",
+	ServicesResource:                 "Service",
+	DeploymentsResource:              "Deployment",
+	ConfigMapsResource:               "ConfigMap",
+	SecretsResource:                  "Secret",
+	IngressResource:                  "Ingress",
+	PersistentVolumeClaimsResource:   "PersistentVolumeClaim",
+	ReplicaSetsResource:              "ReplicaSet",
+	DaemonSetsResource:               "DaemonSet",
+	StatefulSetsResource:             "StatefulSet",
+	JobsResource:                     "Job",
+	CronJobsResource:                 "CronJob",
+	EventsResource:                   "Event",
+	RoutesResource:                   "Route",
+	DeploymentConfigsResource:        "DeploymentConfig",
+	ProjectsResource:                 "Project",
+	BuildConfigsResource:             "BuildConfig",
+	BuildsResource:                   "Build",
+	ImageStreamsResource:             "ImageStream",
+	GatewaysResource:                 "Gateway",
+	HTTPRoutesResource:               "HTTPRoute",
+	GatewayClassesResource:           "GatewayClass",
+	NetworkPoliciesResource:          "NetworkPolicy",
+	HorizontalPodAutoscalersResource: "HorizontalPodAutoscaler",
+	VerticalPodAutoscalersResource:   "VerticalPodAutoscaler",
+}
+
+// kindLabel returns the API kind of a resource type, e.g. "ReplicaSet"
+func kindLabel(rt ResourceType) string {
+	if kind, ok := resourceKinds[rt]; ok {
+		return kind
+	}
+	return rt.String()
+}
+
+// resourceTypeForKind returns the resource type of an API kind, e.g. from an event's involvedObject
+func resourceTypeForKind(kind string) (ResourceType, bool) {
+	for rt, k := range resourceKinds {
+		if k == kind {
+			return rt, true
+		}
+	}
+	return 0, false
+}
+
+// loadOwnershipTree builds the tree containing target, rooted at its top-level controller
+func (m Model) loadOwnershipTree(target K8sResource) tea.Cmd {
+	return func() tea.Msg {
+		scoped := m
+		scoped.selectedNamespace = target.Namespace
+		scoped.selectors = nil
+		
+		loaders := []struct {
+			rt   ResourceType
+			load func() ([]K8sResource, error)
+		}{
+			{CronJobsResource, scoped.loadCronJobs}, {JobsResource, scoped.loadJobs},
+			{DeploymentsResource, scoped.loadDeployments}, {ReplicaSetsResource, scoped.loadReplicaSets},
+			{StatefulSetsResource, scoped.loadStatefulSets}, {DaemonSetsResource, scoped.loadDaemonSets},
+			{PodsResource, scoped.loadPods}, {PersistentVolumeClaimsResource, scoped.loadPersistentVolumeClaims},
+		}
+		
+		var nodes []*ownerNode
+		var skipped []string
+		byUID := map[types.UID]*ownerNode{}
+		for _, loader := range loaders {
+			resources, err := loader.load()
+			if apierrors.IsForbidden(err) {
+				// Namespace-scoped roles often cover only some kinds; show the rest of the tree
+				skipped = append(skipped, loader.rt.String())
+				continue
+			}
+			if err != nil {
+				return ownershipTreeLoadedMsg{err: err}
+			}
+			m.rules.apply(resources, m.now())
+			for _, res := range resources {
+				obj, err := meta.Accessor(res.Object)
+				if err != nil {
+					continue
+				}
+				node := &ownerNode{resource: res, uid: obj.GetUID()}
+				nodes = append(nodes, node)
+				byUID[node.uid] = node
+			}
+		}
+		
+		// Link every object to the owner it references, preferring the controller reference
+		for _, node := range nodes {
+			obj, _ := meta.Accessor(node.resource.Object)
+			var owner *ownerNode
+			for _, ref := range obj.GetOwnerReferences() {
+				candidate, ok := byUID[ref.UID]
+				if !ok {
+					continue
+				}
+				if owner == nil || (ref.Controller != nil && *ref.Controller) {
+					owner = candidate
+				}
+			}
+			if owner != nil {
+				node.parent = owner
+				owner.children = append(owner.children, node)
+			}
+		}
+		linkStatefulSetClaims(nodes)
+		
+		var origin *ownerNode
+		for _, node := range nodes {
+			if node.resource.ResourceType == target.ResourceType && node.resource.Name == target.Name {
+				origin = node
+				break
+			}
+		}
+		if origin == nil {
+			if containsString(skipped, target.ResourceType.String()) {
+				return ownershipTreeLoadedMsg{err: fmt.Errorf("not allowed to list %s", target.ResourceType)}
+			}
+			return ownershipTreeLoadedMsg{err: fmt.Errorf("%s %s not found", kindLabel(target.ResourceType), target.Name)}
+		}
+		
+		root := origin
+		for root.parent != nil {
+			root = root.parent
+		}
+		
+		var rows []OwnerTreeRow
+		originRow := 0
+		var walk func(node *ownerNode, depth int, prefix string, last bool) (int, int)
+		walk = func(node *ownerNode, depth int, prefix string, last bool) (int, int) {
+			branch := ""
+			childPrefix := ""
+			if depth > 0 {
+				branch = prefix + "├─ "
+				childPrefix = prefix + "│  "
+				if last {
+					branch = prefix + "└─ "
+					childPrefix = prefix + "   "
+				}
+			}
+			
+			idx := len(rows)
+			rows = append(rows, OwnerTreeRow{Resource: node.resource, Depth: depth, Prefix: branch})
+			if node == origin {
+				originRow = idx
+			}
+			
+			sort.Slice(node.children, func(i, j int) bool {
+				a, b := node.children[i].resource, node.children[j].resource
+				if a.ResourceType != b.ResourceType {
+					return a.ResourceType < b.ResourceType
+				}
+				return a.Name < b.Name
+			})
+			
+			errs, warns := len(node.resource.Errors), len(node.resource.Warnings)
+			for i, child := range node.children {
+				e, w := walk(child, depth+1, childPrefix, i == len(node.children)-1)
+				errs += e
+				warns += w
+			}
+			rows[idx].RolledErrors = errs
+			rows[idx].RolledWarnings = warns
+			return errs, warns
+		}
+		walk(root, 0, "", true)
+		
+		return ownershipTreeLoadedMsg{rows: rows, origin: originRow, skipped: skipped}
+	}
+}
+
+// linkStatefulSetClaims attaches PVCs created from volumeClaimTemplates (<template>-<statefulset>-<ordinal>)
+// to their StatefulSet; such claims usually carry no ownerReference
+func linkStatefulSetClaims(nodes []*ownerNode) {
+	for _, sts := range nodes {
+		set, ok := sts.resource.Object.(*appsv1.StatefulSet)
+		if !ok {
+			continue
+		}
+		for _, tmpl := range set.Spec.VolumeClaimTemplates {
+			prefix := fmt.Sprintf("%s-%s-", tmpl.Name, set.Name)
+			for _, node := range nodes {
+				if node.parent != nil || node.resource.ResourceType != PersistentVolumeClaimsResource {
+					continue
+				}
+				if ordinal := strings.TrimPrefix(node.resource.Name, prefix); ordinal != node.resource.Name {
+					if _, err := strconv.Atoi(ordinal); err == nil {
+						node.parent = sts
+						sts.children = append(sts.children, node)
+					}
+				}
+			}
+		}
+	}
+}
+
+// renderOwnershipTree renders the ownership tree with rolled-up error and warning counts
+func (m Model) renderOwnershipTree() string {
+	var content strings.Builder
+	headerStyle := lipgloss.NewStyle().Foreground(colors.Success).Bold(true)
+	
+	title := "🌳 Ownership tree"
+	if m.ownershipTarget != nil {
+		title = fmt.Sprintf("🌳 Ownership tree for %s %s", kindLabel(m.ownershipTarget.ResourceType), m.ownershipTarget.Name)
+	}
+	content.WriteString(headerStyle.Render(title) + "\n")
+	if len(m.ownershipSkipped) > 0 {
+		content.WriteString(lipgloss.NewStyle().Foreground(colors.Warning).Render(
+			fmt.Sprintf("🚫 Not shown, listing is forbidden: %s", strings.Join(m.ownershipSkipped, ", "))) + "\n")
+	}
+	content.WriteString("\n")
+	
+	if len(m.ownershipRows) == 0 {
+		if !m.loading {
+			content.WriteString("No ownership information available\n")
+		}
+		return content.String()
+	}
+	
+	normalStyle := lipgloss.NewStyle().Foreground(colors.Text)
+	selectedStyle := colors.selection(colors.Secondary).Padding(0, 1)
+	errorStyle := lipgloss.NewStyle().Foreground(colors.Error)
+	warningStyle := lipgloss.NewStyle().Foreground(colors.Warning)
+	mutedStyle := lipgloss.NewStyle().Foreground(colors.Muted)
+	
+	for i, row := range m.ownershipRows {
+		info := row.Resource.ResourceType.GetResourceInfo()
+		label := fmt.Sprintf("%s %s/%s", info.Icon, kindLabel(row.Resource.ResourceType), row.Resource.Name)
+		if i == m.ownershipOrigin {
+			label += " ◀"
+		}
+		line := fmt.Sprintf("%-60s %-12s %-6s", truncateString(label, 60), truncateString(row.Resource.Status, 12), row.Resource.Age)
+		
+		style := normalStyle
+		if i == m.cursor {
+			style = selectedStyle
+		} else if row.RolledErrors > 0 {
+			style = errorStyle
+		} else if row.RolledWarnings > 0 {
+			style = warningStyle
+		}
+		
+		content.WriteString(mutedStyle.Render(row.Prefix) + style.Render(line))
+		if row.RolledErrors > 0 {
+			content.WriteString(" " + errorStyle.Render(fmt.Sprintf("❌ %d", row.RolledErrors)))
+		}
+		if row.RolledWarnings > 0 {
+			content.WriteString(" " + warningStyle.Render(fmt.Sprintf("⚠️  %d", row.RolledWarnings)))
+		}
+		content.WriteString("\n")
+		
+		// Own findings of the selected object
+		if i == m.cursor {
+			indent := strings.Repeat(" ", len([]rune(row.Prefix))+2)
+			for _, err := range row.Resource.Errors {
+				content.WriteString(errorStyle.Render(indent+"❌ "+err) + "\n")
+			}
+			for _, warning := range row.Resource.Warnings {
+				content.WriteString(warningStyle.Render(indent+"⚠️  "+warning) + "\n")
+			}
+		}
+	}
+	
+	return content.String()
+}
+
+// TrafficPathRow is one line of an Ingress/Route → Service → EndpointSlice → Pod path
+type TrafficPathRow struct {
+	Depth    int
+	Text     string
+	Level    string       // "ok", "warning", "error" or "" for informational lines
+	Resource *K8sResource // Object the line refers to (pods open logs/events), may be nil
+}
+
+// supportsTrafficPath reports whether a traffic path can be traced from a resource type
+func supportsTrafficPath(rt ResourceType) bool {
+	return rt == ServicesResource || rt == IngressResource || rt == RoutesResource
+}
+
+// loadTrafficPath traces the path from target down to the pods serving it
+func (m Model) loadTrafficPath(target K8sResource) tea.Cmd {
+	return func() tea.Msg {
+		scoped := m
+		scoped.selectedNamespace = target.Namespace
+		scoped.selectors = nil
+		
+		pods, err := scoped.loadPods()
+		if err != nil {
+			return trafficPathLoadedMsg{err: err}
+		}
+		m.rules.apply(pods, m.now())
+		
+		var rows []TrafficPathRow
+		switch obj := target.Object.(type) {
+		case *corev1.Service:
+			rows = scoped.serviceTrafficRows(obj.Name, "", nil, pods, 0)
+		case *networkingv1.Ingress:
+			rows = scoped.ingressTrafficRows(obj, target, pods)
+		case *routev1.Route:
+			rows = scoped.routeTrafficRows(obj, target, pods)
+		default:
+			return trafficPathLoadedMsg{err: fmt.Errorf("traffic path is not available for %s", target.ResourceType)}
+		}
+		
+		return trafficPathLoadedMsg{rows: rows}
+	}
+}
+
+// ingressTrafficRows traces every rule and path of an Ingress to its backend services
+func (m Model) ingressTrafficRows(ing *networkingv1.Ingress, res K8sResource, pods []K8sResource) []TrafficPathRow {
+	rows := []TrafficPathRow{{
+		Text:     fmt.Sprintf("🌐 Ingress %s (class %s, address %s)", ing.Name, valueOrNone(stringPtrValue(ing.Spec.IngressClassName)), valueOrNone(res.Details["Address"])),
+		Resource: &res,
+	}}
+	for _, warning := range res.Warnings {
+		rows = append(rows, TrafficPathRow{Depth: 1, Text: warning, Level: "warning"})
+	}
+	
+	if ing.Spec.DefaultBackend != nil {
+		rows = append(rows, TrafficPathRow{Depth: 1, Text: "➡️  default backend"})
+		rows = append(rows, m.ingressBackendRows(*ing.Spec.DefaultBackend, pods, 2)...)
+	}
+	for _, rule := range ing.Spec.Rules {
+		host := rule.Host
+		if host == "" {
+			host = "*"
+		}
+		if rule.HTTP == nil {
+			rows = append(rows, TrafficPathRow{Depth: 1, Text: fmt.Sprintf("➡️  %s has no HTTP paths", host), Level: "warning"})
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			pathType := ""
+			if path.PathType != nil {
+				pathType = string(*path.PathType)
+			}
+			rows = append(rows, TrafficPathRow{Depth: 1, Text: fmt.Sprintf("➡️  %s%s (%s)", host, path.Path, valueOrNone(pathType))})
+			rows = append(rows, m.ingressBackendRows(path.Backend, pods, 2)...)
+		}
+	}
+	
+	return rows
+}
+
+// ingressBackendRows traces a single Ingress backend
+func (m Model) ingressBackendRows(backend networkingv1.IngressBackend, pods []K8sResource, depth int) []TrafficPathRow {
+	if backend.Service == nil {
+		if backend.Resource != nil {
+			return []TrafficPathRow{{Depth: depth, Text: fmt.Sprintf("Resource backend %s %s is not traced", backend.Resource.Kind, backend.Resource.Name)}}
+		}
+		return []TrafficPathRow{{Depth: depth, Text: "Backend has no service", Level: "error"}}
+	}
+	
+	port := backend.Service.Port
+	portDesc := port.Name
+	if portDesc == "" {
+		portDesc = strconv.Itoa(int(port.Number))
+	}
+	matchPort := func(sp corev1.ServicePort) bool {
+		if port.Name != "" {
+			return sp.Name == port.Name
+		}
+		return sp.Port == port.Number
+	}
+	return m.serviceTrafficRows(backend.Service.Name, portDesc, matchPort, pods, depth)
+}
+
+// routeTrafficRows traces an OpenShift Route and its alternate backends to their services
+func (m Model) routeTrafficRows(route *routev1.Route, res K8sResource, pods []K8sResource) []TrafficPathRow {
+	termination := "none"
+	if route.Spec.TLS != nil {
+		termination = string(route.Spec.TLS.Termination)
+	}
+	rows := []TrafficPathRow{{
+		Text:     fmt.Sprintf("🛣️  Route %s (%s%s, TLS %s)", route.Name, res.Details["Host"], route.Spec.Path, termination),
+		Level:    levelOf(res),
+		Resource: &res,
+	}}
+	for _, err := range res.Errors {
+		rows = append(rows, TrafficPathRow{Depth: 1, Text: err, Level: "error"})
+	}
+	
+	// A route targetPort names a service port or the target port of the endpoints
+	portDesc := ""
+	var matchPort func(corev1.ServicePort) bool
+	if route.Spec.Port != nil {
+		target := route.Spec.Port.TargetPort
+		portDesc = target.String()
+		matchPort = func(sp corev1.ServicePort) bool {
+			if sp.Name == target.String() || sp.TargetPort.String() == target.String() {
+				return true
+			}
+			return sp.TargetPort.IntValue() == 0 && strconv.Itoa(int(sp.Port)) == target.String()
+		}
+	}
+	
+	backends := append([]routev1.RouteTargetReference{route.Spec.To}, route.Spec.AlternateBackends...)
+	for _, backend := range backends {
+		weight := "default"
+		if backend.Weight != nil {
+			weight = strconv.Itoa(int(*backend.Weight))
+		}
+		if backend.Kind != "" && backend.Kind != "Service" {
+			rows = append(rows, TrafficPathRow{Depth: 1, Text: fmt.Sprintf("➡️  %s %s is not traced", backend.Kind, backend.Name)})
+			continue
+		}
+		rows = append(rows, TrafficPathRow{Depth: 1, Text: fmt.Sprintf("➡️  backend %s (weight %s)", backend.Name, weight)})
+		rows = append(rows, m.serviceTrafficRows(backend.Name, portDesc, matchPort, pods, 2)...)
+	}
+	
+	return rows
+}
+
+// serviceTrafficRows traces a service through its selector and EndpointSlices to pods.
+// portDesc and matchPort describe the port an Ingress or Route sends traffic to, if any.
+func (m Model) serviceTrafficRows(name, portDesc string, matchPort func(corev1.ServicePort) bool, pods []K8sResource, depth int) []TrafficPathRow {
+	svc, err := m.clientset.CoreV1().Services(m.selectedNamespace).Get(m.ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return []TrafficPathRow{{Depth: depth, Text: fmt.Sprintf("🔗 Service %s: %v", name, err), Level: "error"}}
+	}
+	
+	svcRes := K8sResource{
+		Name:         svc.Name,
+		Namespace:    svc.Namespace,
+		Status:       "Active",
+		Age:          humanAge(m.now().Sub(svc.CreationTimestamp.Time)),
+		ResourceType: ServicesResource,
+		Object:       svc,
+	}
+	rows := []TrafficPathRow{{Depth: depth, Resource: &svcRes}}
+	svcRow := 0
+	
+	if svc.Spec.Type == corev1.ServiceTypeExternalName {
+		rows[svcRow].Text = fmt.Sprintf("🔗 Service %s (ExternalName → %s)", svc.Name, svc.Spec.ExternalName)
+		return rows
+	}
+	
+	if matchPort != nil {
+		found := false
+		for _, sp := range svc.Spec.Ports {
+			if matchPort(sp) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			rows = append(rows, TrafficPathRow{Depth: depth + 1, Text: fmt.Sprintf("Port %s is not exposed by the service", portDesc), Level: "error"})
+		}
+	}
+	
+	// Selector: which labels match no pods at all, and which pods match all of them
+	var selected []K8sResource
+	if len(svc.Spec.Selector) == 0 {
+		rows = append(rows, TrafficPathRow{Depth: depth + 1, Text: "No selector: endpoints are managed outside Kubernetes"})
+	} else {
+		selector := labels.SelectorFromSet(svc.Spec.Selector)
+		var keys []string
+		for key := range svc.Spec.Selector {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		
+		unmatched := 0
+		for _, key := range keys {
+			count := 0
+			for _, pod := range pods {
+				if obj, ok := pod.Object.(*corev1.Pod); ok && obj.Labels[key] == svc.Spec.Selector[key] {
+					count++
+				}
+			}
+			if count == 0 {
+				unmatched++
+				rows = append(rows, TrafficPathRow{Depth: depth + 1, Text: fmt.Sprintf("Selector label %s=%s matches no pods", key, svc.Spec.Selector[key]), Level: "error"})
+			}
+		}
+		for _, pod := range pods {
+			if obj, ok := pod.Object.(*corev1.Pod); ok && selector.Matches(labels.Set(obj.Labels)) {
+				selected = append(selected, pod)
+			}
+		}
+		switch {
+		case len(selected) > 0:
+			rows = append(rows, TrafficPathRow{Depth: depth + 1, Text: fmt.Sprintf("Selector %s matches %d pod(s)", selector, len(selected)), Level: "ok"})
+		case unmatched == 0:
+			rows = append(rows, TrafficPathRow{Depth: depth + 1, Text: fmt.Sprintf("Selector %s matches no pods: its labels never occur together", selector), Level: "error"})
+		}
+	}
+	
+	// Ports: target ports must exist on the selected pods' containers
+	if len(selected) > 0 {
+		for _, sp := range svc.Spec.Ports {
+			rows = append(rows, checkServicePort(sp, selected, depth+1)...)
+		}
+	}
+	
+	// EndpointSlices and the pods behind them
+	slices, err := m.clientset.DiscoveryV1().EndpointSlices(svc.Namespace).List(m.ctx, metav1.ListOptions{
+		LabelSelector: discoveryv1.LabelServiceName + "=" + svc.Name,
+	})
+	if err != nil {
+		rows = append(rows, TrafficPathRow{Depth: depth + 1, Text: fmt.Sprintf("EndpointSlices: %v", err), Level: "error"})
+		rows[svcRow].Text = fmt.Sprintf("🔗 Service %s (%s) ports %s", svc.Name, svc.Spec.Type, servicePortsString(svc.Spec.Ports))
+		return rows
+	}
+	if len(slices.Items) == 0 && len(svc.Spec.Selector) > 0 {
+		rows = append(rows, TrafficPathRow{Depth: depth + 1, Text: "No EndpointSlices", Level: "error"})
+	}
+	
+	podsByName := map[string]K8sResource{}
+	for _, pod := range pods {
+		podsByName[pod.Name] = pod
+	}
+	inSlice := map[string]bool{}
+	ready, total := 0, 0
+	for _, slice := range slices.Items {
+		var slicePorts []string
+		for _, port := range slice.Ports {
+			slicePorts = append(slicePorts, fmt.Sprintf("%s:%d", stringPtrValue(port.Name), valueOrZero(port.Port)))
+		}
+		rows = append(rows, TrafficPathRow{Depth: depth + 1, Text: fmt.Sprintf("🧩 EndpointSlice %s (%s) ports %s", slice.Name, slice.AddressType, valueOrNone(strings.Join(slicePorts, ",")))})
+		
+		for _, ep := range slice.Endpoints {
+			state, level := endpointState(ep.Conditions)
+			total++
+			if level == "ok" {
+				ready++
+			}
+			
+			row := TrafficPathRow{Depth: depth + 2, Level: level}
+			target := "no target"
+			if ep.TargetRef != nil {
+				target = fmt.Sprintf("%s %s", ep.TargetRef.Kind, ep.TargetRef.Name)
+				if ep.TargetRef.Kind == "Pod" {
+					inSlice[ep.TargetRef.Name] = true
+					if pod, ok := podsByName[ep.TargetRef.Name]; ok {
+						row.Resource = &pod
+						target = fmt.Sprintf("Pod %s (%s)", pod.Name, pod.Status)
+					}
+				}
+			}
+			node := ""
+			if ep.NodeName != nil {
+				node = " on " + *ep.NodeName
+			}
+			row.Text = fmt.Sprintf("%s %s → %s%s", strings.Join(ep.Addresses, ","), state, target, node)
+			rows = append(rows, row)
+		}
+	}
+	
+	for _, pod := range selected {
+		if !inSlice[pod.Name] {
+			pod := pod
+			rows = append(rows, TrafficPathRow{Depth: depth + 1, Text: fmt.Sprintf("Pod %s (%s) is selected but has no endpoint", pod.Name, pod.Status), Level: "warning", Resource: &pod})
+		}
+	}
+	
+	rows[svcRow].Text = fmt.Sprintf("🔗 Service %s (%s) ports %s, %d/%d endpoints ready", svc.Name, svc.Spec.Type, servicePortsString(svc.Spec.Ports), ready, total)
+	switch {
+	case len(svc.Spec.Selector) > 0 && ready == 0:
+		rows[svcRow].Level = "error"
+	case ready < total:
+		rows[svcRow].Level = "warning"
+	case total > 0:
+		rows[svcRow].Level = "ok"
+	}
+	
+	return rows
+}
+
+// checkServicePort compares a service port's targetPort with the container ports of the selected pods
+func checkServicePort(sp corev1.ServicePort, selected []K8sResource, depth int) []TrafficPathRow {
+	portName := sp.Name
+	if portName == "" {
+		portName = strconv.Itoa(int(sp.Port))
+	}
+	
+	declared := false
+	for _, pod := range selected {
+		obj := pod.Object.(*corev1.Pod)
+		for _, container := range obj.Spec.Containers {
+			for _, cp := range container.Ports {
+				declared = true
+				if sp.TargetPort.Type == intstr.String {
+					if cp.Name == sp.TargetPort.StrVal {
+						return nil
+					}
+				} else if targetPortNumber(sp) == cp.ContainerPort {
+					return nil
+				}
+			}
+		}
+	}
+	
+	if sp.TargetPort.Type == intstr.String {
+		return []TrafficPathRow{{Depth: depth, Text: fmt.Sprintf("Port %s: targetPort %q is not a named container port of any selected pod", portName, sp.TargetPort.StrVal), Level: "error"}}
+	}
+	if declared {
+		// Undeclared container ports still receive traffic, so this is only suspicious
+		return []TrafficPathRow{{Depth: depth, Text: fmt.Sprintf("Port %s: targetPort %d is not declared by any selected pod container", portName, targetPortNumber(sp)), Level: "warning"}}
+	}
+	return nil
+}
+
+// targetPortNumber returns the numeric target port of a service port, defaulting to its port
+func targetPortNumber(sp corev1.ServicePort) int32 {
+	if sp.TargetPort.IntVal == 0 {
+		return sp.Port
+	}
+	return sp.TargetPort.IntVal
+}
+
+// endpointState describes an endpoint's ready/serving/terminating conditions
+func endpointState(c discoveryv1.EndpointConditions) (string, string) {
+	ready := c.Ready == nil || *c.Ready
+	serving := ready
+	if c.Serving != nil {
+		serving = *c.Serving
+	}
+	terminating := c.Terminating != nil && *c.Terminating
+	
+	switch {
+	case terminating && serving:
+		return "terminating (serving)", "warning"
+	case terminating:
+		return "terminating", "warning"
+	case ready:
+		return "ready", "ok"
+	case serving:
+		return "serving (not ready)", "warning"
+	}
+	return "not ready", "error"
+}
+
+// levelOf maps a resource's findings to a traffic path level
+func levelOf(res K8sResource) string {
+	if len(res.Errors) > 0 {
+		return "error"
+	}
+	if len(res.Warnings) > 0 {
+		return "warning"
+	}
+	return ""
+}
+
+// valueOrNone returns s, or "none" when it is empty
+func valueOrNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
+
+// valueOrZero dereferences an optional port number
+func valueOrZero(p *int32) int32 {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
+// renderTrafficPath renders the traffic path with the health of each hop
+func (m Model) renderTrafficPath() string {
+	var content strings.Builder
+	headerStyle := lipgloss.NewStyle().Foreground(colors.Success).Bold(true)
+	
+	title := "🚦 Traffic path"
+	if m.trafficTarget != nil {
+		title = fmt.Sprintf("🚦 Traffic path for %s %s", kindLabel(m.trafficTarget.ResourceType), m.trafficTarget.Name)
+	}
+	content.WriteString(headerStyle.Render(title) + "\n")
+	
+	if len(m.trafficRows) == 0 {
+		if !m.loading {
+			content.WriteString("\nNo traffic path available\n")
+		}
+		return content.String()
+	}
+	
+	normalStyle := lipgloss.NewStyle().Foreground(colors.Text)
+	selectedStyle := colors.selection(colors.Secondary).Padding(0, 1)
+	errorStyle := lipgloss.NewStyle().Foreground(colors.Error)
+	warningStyle := lipgloss.NewStyle().Foreground(colors.Warning)
+	okStyle := lipgloss.NewStyle().Foreground(colors.Success)
+	
+	problems, warnings := 0, 0
+	for _, row := range m.trafficRows {
+		switch row.Level {
+		case "error":
+			problems++
+		case "warning":
+			warnings++
+		}
+	}
+	summary := okStyle.Render("✅ No problems found on this path")
+	if problems > 0 || warnings > 0 {
+		summary = errorStyle.Render(fmt.Sprintf("❌ %d problem(s)", problems)) + "  " + warningStyle.Render(fmt.Sprintf("⚠️  %d warning(s)", warnings))
+	}
+	content.WriteString(summary + "\n\n")
+	
+	for i, row := range m.trafficRows {
+		icon := ""
+		style := normalStyle
+		switch row.Level {
+		case "ok":
+			icon, style = "✅ ", okStyle
+		case "warning":
+			icon, style = "⚠️  ", warningStyle
+		case "error":
+			icon, style = "❌ ", errorStyle
+		}
+		if i == m.cursor {
+			style = selectedStyle
+		}
+		content.WriteString(strings.Repeat("  ", row.Depth) + style.Render(icon+row.Text) + "\n")
+	}
+	
+	return content.String()
+}
+
+// healthScanKinds are the resource types scanned by the cluster health dashboard
+var healthScanKinds = []ResourceType{
+	NodesResource, PersistentVolumesResource,
+	PodsResource, DeploymentsResource, StatefulSetsResource, DaemonSetsResource, ReplicaSetsResource,
+	JobsResource, CronJobsResource, ServicesResource, IngressResource, PersistentVolumeClaimsResource,
+}
+
+// dashboardRefreshInterval limits how often auto-refresh rescans the whole cluster
+const dashboardRefreshInterval = 30 * time.Second
+
+// namespaceHealth counts the problems found in one namespace
+type namespaceHealth struct {
+	Namespace        string
+	FailingPods      int
+	UnreadyWorkloads int
+	PendingPVCs      int
+	WarningEvents    int
+	Findings         int // Resources with errors or warnings
+}
+
+// DashboardItem is one offending object listed on the cluster health dashboard
+type DashboardItem struct {
+	Resource  K8sResource // The object itself, or the object an event is about
+	Severity  string      // "error" or "warning"
+	Summary   string      // First finding or event message
+	IsEvent   bool        // Warning event rather than a finding of the object
+	Drillable bool        // Resource is a kind k8sGo can list
+}
+
+// ClusterHealth is the result of a cluster-wide health scan
+type ClusterHealth struct {
+	Namespaces    []namespaceHealth
+	Items         []DashboardItem
+	NodesTotal    int
+	NodesNotReady int
+	ScanErrors    []string // Kinds that could not be scanned, e.g. forbidden
+	ScannedAt     time.Time
+}
+
+// isWorkload reports whether a resource type manages pods
+func isWorkload(rt ResourceType) bool {
+	switch rt {
+	case DeploymentsResource, StatefulSetsResource, DaemonSetsResource, ReplicaSetsResource,
+		JobsResource, CronJobsResource, DeploymentConfigsResource:
+		return true
+	}
+	return false
+}
+
+// scanClusterHealth scans every supported kind across all namespaces
+func (m Model) scanClusterHealth() tea.Cmd {
+	return func() tea.Msg {
+		scoped := m
+		scoped.selectedNamespace = metav1.NamespaceAll
+		scoped.selectors = nil
+		
+		health := &ClusterHealth{ScannedAt: time.Now()}
+		byNamespace := map[string]*namespaceHealth{}
+		namespaceOf := func(ns string) *namespaceHealth {
+			if byNamespace[ns] == nil {
+				byNamespace[ns] = &namespaceHealth{Namespace: ns}
+			}
+			return byNamespace[ns]
+		}
+		
+		kinds := append([]ResourceType{}, healthScanKinds...)
+		if m.isOpenShift {
+			kinds = append(kinds, RoutesResource, DeploymentConfigsResource)
+		}
+		
+		for _, rt := range kinds {
+			resources, err := scoped.fetchResources(rt)
+			if err != nil {
+				if isConnectionError(err) {
+					return clusterHealthLoadedMsg{err: err}
+				}
+				health.ScanErrors = append(health.ScanErrors, fmt.Sprintf("%s: %v", rt, err))
+				continue
+			}
+			
+			for _, res := range resources {
+				if rt == NodesResource {
+					health.NodesTotal++
+					if res.Status != "Ready" {
+						health.NodesNotReady++
+					}
+				}
+				if len(res.Errors) == 0 && len(res.Warnings) == 0 {
+					continue
+				}
+				
+				item := DashboardItem{Resource: res, Severity: "warning", Drillable: hasLoader(rt)}
+				if len(res.Errors) > 0 {
+					item.Severity = "error"
+					item.Summary = res.Errors[0]
+				} else {
+					item.Summary = res.Warnings[0]
+				}
+				health.Items = append(health.Items, item)
+				
+				if res.Namespace == "" {
+					continue
+				}
+				ns := namespaceOf(res.Namespace)
+				ns.Findings++
+				switch {
+				case rt == PodsResource && len(res.Errors) > 0:
+					ns.FailingPods++
+				case isWorkload(rt) && len(res.Errors) > 0:
+					ns.UnreadyWorkloads++
+				case rt == PersistentVolumeClaimsResource && res.Status == string(corev1.ClaimPending):
+					ns.PendingPVCs++
+				}
+			}
+		}
+		
+		// Warning events, one item per involved object
+		events, err := m.clientset.CoreV1().Events(metav1.NamespaceAll).List(m.ctx, metav1.ListOptions{FieldSelector: "type=Warning"})
+		if err != nil {
+			health.ScanErrors = append(health.ScanErrors, fmt.Sprintf("Events: %v", err))
+		} else {
+			seen := map[string]int{}
+			for _, event := range events.Items {
+				namespaceOf(event.Namespace).WarningEvents++
+				
+				key := event.Namespace + "/" + event.InvolvedObject.Kind + "/" + event.InvolvedObject.Name
+				if idx, ok := seen[key]; ok {
+					// Keep the most recent message for the object
+					if event.LastTimestamp.Time.After(health.Items[idx].Resource.Object.(*corev1.Event).LastTimestamp.Time) {
+						health.Items[idx].Summary = fmt.Sprintf("%s: %s", event.Reason, event.Message)
+						health.Items[idx].Resource.Object = event.DeepCopy()
+					}
+					continue
+				}
+				
+				rt, known := resourceTypeForKind(event.InvolvedObject.Kind)
+				seen[key] = len(health.Items)
+				health.Items = append(health.Items, DashboardItem{
+					Resource: K8sResource{
+						Name:         event.InvolvedObject.Name,
+						Namespace:    event.Namespace,
+						Status:       event.InvolvedObject.Kind,
+						ResourceType: rt,
+						Object:       event.DeepCopy(),
+					},
+					Severity: "warning",
+					Summary:  fmt.Sprintf("%s: %s", event.Reason, event.Message),
+					IsEvent:   true,
+					Drillable: known && hasLoader(rt),
+				})
+			}
+		}
+		
+		for _, ns := range byNamespace {
+			health.Namespaces = append(health.Namespaces, *ns)
+		}
+		sort.Slice(health.Namespaces, func(i, j int) bool {
+			a, b := health.Namespaces[i], health.Namespaces[j]
+			ta := a.FailingPods + a.UnreadyWorkloads + a.PendingPVCs
+			tb := b.FailingPods + b.UnreadyWorkloads + b.PendingPVCs
+			if ta != tb {
+				return ta > tb
+			}
+			if a.Findings+a.WarningEvents != b.Findings+b.WarningEvents {
+				return a.Findings+a.WarningEvents > b.Findings+b.WarningEvents
+			}
+			return a.Namespace < b.Namespace
+		})
+		
+		// Errors first, then findings before events, grouped by namespace
+		sort.SliceStable(health.Items, func(i, j int) bool {
+			a, b := health.Items[i], health.Items[j]
+			if a.Severity != b.Severity {
+				return a.Severity == "error"
+			}
+			if a.IsEvent != b.IsEvent {
+				return !a.IsEvent
+			}
+			if a.Resource.Namespace != b.Resource.Namespace {
+				return a.Resource.Namespace < b.Resource.Namespace
+			}
+			return a.Resource.Name < b.Resource.Name
+		})
+		
+		return clusterHealthLoadedMsg{health: health}
+	}
+}
+
+// openDashboardItem drills down from the dashboard to the offending object
+func (m Model) openDashboardItem() (tea.Model, tea.Cmd) {
+	if m.clusterHealth == nil || m.cursor >= len(m.clusterHealth.Items) {
+		return m, nil
+	}
+	item := m.clusterHealth.Items[m.cursor]
+	
+	// Events about objects k8sGo cannot list open the events view instead
+	if !item.Drillable {
+		return m.openDashboardEvents()
+	}
+	
+	m.selectedResource = item.Resource.ResourceType
+	m.selectedNamespace = item.Resource.Namespace
+	m.selectedScope = item.Resource.ResourceType.GetResourceInfo().Scope
+	m.focusResource = item.Resource.Name
+	m.viewStack = append(m.viewStack, m.currentView)
+	m.currentView = DetailView
+	m.cursor = 0
+	m.loading = true
+	return m, m.loadResources()
+}
+
+// openDashboardEvents shows the events of the object under the cursor on the dashboard
+func (m Model) openDashboardEvents() (tea.Model, tea.Cmd) {
+	if m.clusterHealth == nil || m.cursor >= len(m.clusterHealth.Items) {
+		return m, nil
+	}
+	entry := m.clusterHealth.Items[m.cursor].Resource
+	m.selectedK8sResource = &entry
+	m.selectedNamespace = entry.Namespace
+	m.viewStack = append(m.viewStack, m.currentView)
+	m.currentView = EventView
+	m.eventScrollOffset = 0
+	m.loading = true
+	return m, m.loadEventsCmd()
+}
+
+// renderDashboard renders the per-namespace summary and the list of offending objects
+func (m Model) renderDashboard() string {
+	var content strings.Builder
+	headerStyle := lipgloss.NewStyle().Foreground(colors.Success).Bold(true)
+	content.WriteString(headerStyle.Render(fmt.Sprintf("🩺 Cluster health: %s", m.selectedKubeContext)) + "\n")
+	
+	health := m.clusterHealth
+	if health == nil {
+		if !m.loading {
+			content.WriteString(fmt.Sprintf("\nNo scan results yet (press '%s' to scan)\n", m.keyHint(actionRefresh)))
+		}
+		return content.String()
+	}
+	
+	normalStyle := lipgloss.NewStyle().Foreground(colors.Text)
+	selectedStyle := colors.selection(colors.Secondary)
+	errorStyle := lipgloss.NewStyle().Foreground(colors.Error)
+	warningStyle := lipgloss.NewStyle().Foreground(colors.Warning)
+	okStyle := lipgloss.NewStyle().Foreground(colors.Success)
+	mutedStyle := lipgloss.NewStyle().Foreground(colors.Muted)
+	tableHeaderStyle := lipgloss.NewStyle().Foreground(colors.Info).Bold(true)
+	
+	content.WriteString(mutedStyle.Render(fmt.Sprintf("Scanned %s ago", humanAge(time.Since(health.ScannedAt)))) + "\n\n")
+	
+	// Nodes
+	nodeLine := fmt.Sprintf("🖥️  Nodes: %d total, %d NotReady", health.NodesTotal, health.NodesNotReady)
+	if health.NodesNotReady > 0 {
+		content.WriteString(errorStyle.Render(nodeLine) + "\n")
+	} else {
+		content.WriteString(okStyle.Render(nodeLine) + "\n")
+	}
+	
+	// Per-namespace summary, worst first
+	content.WriteString(tableHeaderStyle.Render(fmt.Sprintf("%-30s %12s %12s %12s %12s %10s",
+		"NAMESPACE", "FAILING PODS", "UNREADY WL", "PENDING PVC", "WARN EVENTS", "FINDINGS")) + "\n")
+	maxNamespaces := 8
+	for i, ns := range health.Namespaces {
+		if i == maxNamespaces {
+			content.WriteString(mutedStyle.Render(fmt.Sprintf("… %d more namespaces", len(health.Namespaces)-maxNamespaces)) + "\n")
+			break
+		}
+		line := fmt.Sprintf("%-30s %12d %12d %12d %12d %10d", truncateString(ns.Namespace, 30),
+			ns.FailingPods, ns.UnreadyWorkloads, ns.PendingPVCs, ns.WarningEvents, ns.Findings)
+		switch {
+		case ns.FailingPods+ns.UnreadyWorkloads+ns.PendingPVCs > 0:
+			content.WriteString(errorStyle.Render(line) + "\n")
+		case ns.Findings+ns.WarningEvents > 0:
+			content.WriteString(warningStyle.Render(line) + "\n")
+		default:
+			content.WriteString(normalStyle.Render(line) + "\n")
+		}
+	}
+	
+	for _, scanErr := range health.ScanErrors {
+		content.WriteString(mutedStyle.Render("🚫 Not scanned: "+scanErr) + "\n")
+	}
+	
+	content.WriteString("\n" + headerStyle.Render(fmt.Sprintf("Problems (%d):", len(health.Items))) + "\n")
+	if len(health.Items) == 0 {
+		content.WriteString(okStyle.Render("✅ No problems found") + "\n")
+		return content.String()
+	}
+	
+	// Rows shown after filtering, indexed like the cursor
+	var rows []int
+	matches := m.filterMatches()
+	position := 0
+	for i := range health.Items {
+		if matches == nil || matches[i] {
+			if i == m.cursor {
+				position = len(rows)
+			}
+			rows = append(rows, i)
+		}
+	}
+	
+	// Keep the cursor visible in the remaining space
+	visible := m.height - 30 - min(len(health.Namespaces), maxNamespaces+1) - len(health.ScanErrors)
+	if visible < 5 {
+		visible = 5
+	}
+	start := 0
+	if position >= visible {
+		start = position - visible + 1
+	}
+	end := min(start+visible, len(rows))
+	
+	for _, i := range rows[start:end] {
+		item := health.Items[i]
+		icon := "⚠️ "
+		style := warningStyle
+		if item.Severity == "error" {
+			icon = "❌"
+			style = errorStyle
+		}
+		kind := kindLabel(item.Resource.ResourceType)
+		if item.IsEvent {
+			kind = "📢 " + item.Resource.Status
+		}
+		name := item.Resource.Name
+		if item.Resource.Namespace != "" {
+			name = item.Resource.Namespace + "/" + name
+		}
+		line := fmt.Sprintf("%s %-22s %-50s %s", icon, truncateString(kind, 22), truncateString(name, 50), item.Summary)
+		if m.width > 20 {
+			line = truncateString(line, m.width-2)
+		}
+		if i == m.cursor {
+			style = selectedStyle
+		}
+		content.WriteString(m.highlightMatches(line, style) + "\n")
+	}
+	if len(rows) > visible {
+		content.WriteString(mutedStyle.Render(fmt.Sprintf("Showing %d-%d of %d", start+1, end, len(rows))) + "\n")
+	}
+	
+	return content.String()
+}
+
+// Additional OpenShift resource loading functions
+func (m Model) loadBuildConfigs() ([]K8sResource, error) { return []K8sResource{}, nil }
+func (m Model) loadBuilds() ([]K8sResource, error) { return []K8sResource{}, nil }
+func (m Model) loadImageStreams() ([]K8sResource, error) { return []K8sResource{}, nil }
+
+// Gateway API resource loading functions
+func (m Model) loadGateways() ([]K8sResource, error) { return []K8sResource{}, nil }
+func (m Model) loadHTTPRoutes() ([]K8sResource, error) { return []K8sResource{}, nil }
+func (m Model) loadGatewayClasses() ([]K8sResource, error) { return []K8sResource{}, nil }
+
+// Additional resource loading functions
+func (m Model) loadNetworkPolicies() ([]K8sResource, error) { return []K8sResource{}, nil }
+func (m Model) loadHorizontalPodAutoscalers() ([]K8sResource, error) { return []K8sResource{}, nil }
+func (m Model) loadVerticalPodAutoscalers() ([]K8sResource, error) { return []K8sResource{}, nil }
+
+// OpenShift-specific resource loading functions
+func (m Model) loadRoutes() ([]K8sResource, error) {
+	if m.routeClient == nil {
+		return nil, fmt.Errorf("OpenShift Route client not available")
+	}
+	
+	routes, err := m.routeClient.RouteV1().Routes(m.selectedNamespace).List(m.ctx, m.listOptions(RoutesResource))
+	if err != nil {
+		return nil, err
+	}
+	
+	var resources []K8sResource
+	now := m.now()
+	
+	for _, route := range routes.Items {
+		age := humanAge(now.Sub(route.CreationTimestamp.Time))
+		
+		status := "Active"
+		for _, condition := range route.Status.Ingress {
+			for _, cond := range condition.Conditions {
+				if cond.Type == routev1.RouteAdmitted && cond.Status != corev1.ConditionTrue {
+					status = "NotAdmitted"
+				}
+			}
+		}
+		
+		// Extract host
+		host := route.Spec.Host
+		if host == "" && len(route.Status.Ingress) > 0 {
+			host = route.Status.Ingress[0].Host
+		}
+		
+		resource := K8sResource{
+			Name:         route.Name,
+			Namespace:    route.Namespace,
+			Status:       status,
+			Age:          age,
+			ResourceType: RoutesResource,
+			Object:       route.DeepCopy(),
+			Details: map[string]string{
+				"Host":    host,
+				"Path":    route.Spec.Path,
+				"Service": route.Spec.To.Name,
+				"Port":    fmt.Sprintf("%v", route.Spec.Port),
+			},
+		}
+		resources = append(resources, resource)
+	}
+	
+	return resources, nil
+}
+
+func (m Model) loadDeploymentConfigs() ([]K8sResource, error) {
+	if m.openshiftAppsClient == nil {
+		return nil, fmt.Errorf("OpenShift Apps client not available")
+	}
+	
+	dcs, err := m.openshiftAppsClient.AppsV1().DeploymentConfigs(m.selectedNamespace).List(m.ctx, m.listOptions(DeploymentConfigsResource))
+	if err != nil {
+		return nil, err
+	}
+	
+	var resources []K8sResource
+	now := m.now()
+	
+	for _, dc := range dcs.Items {
+		age := humanAge(now.Sub(dc.CreationTimestamp.Time))
+		
+		ready := fmt.Sprintf("%d/%d", dc.Status.ReadyReplicas, dc.Status.Replicas)
+		status := "Running"
+		if dc.Status.ReadyReplicas != dc.Status.Replicas {
+			status = "NotReady"
+		}
+		
+		resource := K8sResource{
+			Name:         dc.Name,
+			Namespace:    dc.Namespace,
+			Status:       status,
+			Age:          age,
+			ResourceType: DeploymentConfigsResource,
+			Object:       dc.DeepCopy(),
+			Details: map[string]string{
+				"Ready":           ready,
+				"Available":       fmt.Sprintf("%d", dc.Status.AvailableReplicas),
+				"Latest Version":  fmt.Sprintf("%d", dc.Status.LatestVersion),
+				"Observed Gen":    fmt.Sprintf("%d", dc.Status.ObservedGeneration),
+			},
+		}
+		resources = append(resources, resource)
+	}
+	
+	return resources, nil
+}
+
+func (m Model) loadProjects() ([]K8sResource, error) {
+	if m.projectClient == nil {
+		return nil, fmt.Errorf("OpenShift Project client not available")
+	}
+	
+	projects, err := m.projectClient.ProjectV1().Projects().List(m.ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	
+	var resources []K8sResource
+	now := m.now()
+	
+	for _, project := range projects.Items {
+		age := humanAge(now.Sub(project.CreationTimestamp.Time))
+		
+		status := string(project.Status.Phase)
+		
+		resource := K8sResource{
+			Name:         project.Name,
+			Namespace:    "", // Projects are cluster-scoped
+			Status:       status,
+			Age:          age,
+			ResourceType: ProjectsResource,
+			Object:       project.DeepCopy(),
+			Details: map[string]string{
+				"Display Name": project.Annotations["openshift.io/display-name"],
+				"Description":  project.Annotations["openshift.io/description"],
+				"Requester":    project.Annotations["openshift.io/requester"],
+			},
+		}
+		resources = append(resources, resource)
+	}
+	
+	return resources, nil
+}
+
+// renderMultiFrameView creates the multi-frame layout with resources, logs, and events
+func (m Model) renderMultiFrameView() string {
+	if m.frameWidth == 0 {
+		m.frameWidth = m.width / 3
+		m.frameHeight = m.height - 10
+	}
+	
+	var content strings.Builder
+	
+	// Frame headers
+	headerStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colors.Text).
+		Background(colors.Primary).
+		Padding(0, 1).
+		Width(m.frameWidth - 2)
+	
+	// Left frame: Resources
+	resourceHeaderText := "📦 Resources"
+	if m.currentFrame == ResourceFrame {
+		resourceHeaderText = "▶ " + resourceHeaderText
+	}
+	resourceHeader := headerStyle.Render(resourceHeaderText)
+	
+	// Middle frame: Logs
+	logHeaderText := "📜 Logs"
+	if m.selectedK8sResource != nil {
+		logHeaderText = fmt.Sprintf("📜 Logs - %s", m.selectedK8sResource.Name)
+	}
+	if m.currentFrame == LogFrame {
+		logHeaderText = "▶ " + logHeaderText
+	}
+	logHeader := headerStyle.Render(logHeaderText)
+	
+	// Right frame: Events
+	eventHeaderText := "📢 Events"
+	if m.selectedK8sResource != nil {
+		eventHeaderText = fmt.Sprintf("📢 Events - %s", m.selectedK8sResource.Name)
+	}
+	if m.currentFrame == EventFrame {
+		eventHeaderText = "▶ " + eventHeaderText
+	}
+	eventHeader := headerStyle.Render(eventHeaderText)
+	
+	// Render frame headers
+	content.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, resourceHeader, " ", logHeader, " ", eventHeader) + "\n")
+	
+	// Frame content styles; the metrics frame below the three columns takes about 7 lines
+	columnHeight := m.frameHeight - 4 - 7
+	if columnHeight < 5 {
+		columnHeight = 5
+	}
+	frameStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(colors.Secondary).
+		Width(m.frameWidth - 4).
+		Height(columnHeight).
+		Padding(1)
+	
+	activeFrameStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(colors.Primary).
+		Width(m.frameWidth - 4).
+		Height(columnHeight).
+		Padding(1)
+	
+	// Left frame: Resources content
+	var resourceContent string
+	if len(m.resources) == 0 {
+		resourceContent = "No resources found"
+	} else {
+		var resourceLines strings.Builder
+		matches := m.filterMatches()
+		for i, resource := range m.resources {
+			if matches != nil && !matches[i] {
+				continue
+			}
+			prefix := "  "
+			if change, ok := m.rowChange(resource); ok {
+				prefix = changeStyle(change.Change).Render(changeMarker(change.Change)) + " "
+			}
+			style := lipgloss.NewStyle().Foreground(colors.Text)
+			if i == m.cursor && m.currentFrame == ResourceFrame {
+				prefix = "▶ "
+				style = colors.selection(colors.Secondary).Padding(0, 1)
+			}
+			
+			// Resource status color
+			if len(resource.Errors) > 0 {
+				style = style.Foreground(colors.Error)
+			} else if len(resource.Warnings) > 0 {
+				style = style.Foreground(colors.Warning)
+			}
+			
+			resourceLines.WriteString(prefix + m.highlightMatches(fmt.Sprintf("%-25s %s", 
+				truncateString(resource.Name, 25), resource.Status), style) + "\n")
+		}
+		resourceContent = resourceLines.String()
+	}
+	
+	// Middle frame: Logs content
+	var logContent string
+	if m.selectedK8sResource == nil {
+		logContent = "Select a resource to view logs"
+	} else if len(m.logEntries) == 0 {
+		if m.loading {
+			logContent = "Loading logs..."
+		} else {
+			logContent = "No logs available for this resource"
+		}
+	} else {
+		var logLines strings.Builder
+		startIdx := m.logScrollOffset
+		endIdx := min(startIdx + m.frameHeight - 6, len(m.logEntries))
+		
+		for i := startIdx; i < endIdx; i++ {
+			entry := m.logEntries[i]
+			timestamp := entry.Timestamp.Format("15:04:05")
+			logLines.WriteString(fmt.Sprintf("[%s] %s\n", timestamp, entry.Message))
+		}
+		logContent = logLines.String()
+	}
+	
+	// Right frame: Events content
+	var eventContent string
+	if m.selectedK8sResource == nil {
+		eventContent = "Select a resource to view events"
+	} else if len(m.eventEntries) == 0 {
+		if m.loading {
+			eventContent = "Loading events..."
+		} else {
+			eventContent = "No events found for this resource"
+		}
+	} else {
+		var eventLines strings.Builder
+		startIdx := m.eventScrollOffset
+		endIdx := min(startIdx + m.frameHeight - 6, len(m.eventEntries))
+		
+		for i := startIdx; i < endIdx; i++ {
+			event := m.eventEntries[i]
+			timestamp := event.Timestamp.Format("15:04:05")
+			eventLines.WriteString(fmt.Sprintf("[%s] %s: %s\n", timestamp, event.Reason, event.Message))
+		}
+		eventContent = eventLines.String()
+	}
+	
+	// Apply frame styles
+	var leftFrame, middleFrame, rightFrame string
+	if m.currentFrame == ResourceFrame {
+		leftFrame = activeFrameStyle.Render(resourceContent)
+		middleFrame = frameStyle.Render(logContent)
+		rightFrame = frameStyle.Render(eventContent)
+	} else if m.currentFrame == LogFrame {
+		leftFrame = frameStyle.Render(resourceContent)
+		middleFrame = activeFrameStyle.Render(logContent)
+		rightFrame = frameStyle.Render(eventContent)
+	} else {
+		leftFrame = frameStyle.Render(resourceContent)
+		middleFrame = frameStyle.Render(logContent)
+		rightFrame = activeFrameStyle.Render(eventContent)
+	}
+	
+	// Join frames horizontally
+	content.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, leftFrame, " ", middleFrame, " ", rightFrame))
+	
+	// Bottom frame: metric history of the resource under the cursor
+	metricsHeaderText := "📈 Metrics"
+	var metricsContent string
+	if target := m.metricsTarget(); target != nil {
+		metricsHeaderText = fmt.Sprintf("📈 Metrics - %s", target.Name)
+		if target.ResourceType == PodsResource || target.ResourceType == NodesResource {
+			sparkWidth := m.frameWidth*3 - 40
+			if sparkWidth < 20 {
+				sparkWidth = 20
+			}
+			metricsContent = strings.TrimRight(m.renderMetricHistory(*target, min(sparkWidth, metricHistoryLimit), ""), "\n")
+		} else {
+			metricsContent = "Metric history is recorded for pods and nodes"
+		}
+	} else {
+		metricsContent = "Select a pod or node to view its metric history"
+	}
+	if m.currentFrame == MetricsFrame {
+		metricsHeaderText = "▶ " + metricsHeaderText
+	}
+	metricsFrameStyle := frameStyle
+	if m.currentFrame == MetricsFrame {
+		metricsFrameStyle = activeFrameStyle
+	}
+	metricsFrameStyle = metricsFrameStyle.Width(m.frameWidth*3 - 8).Height(0).Padding(0, 1)
+	content.WriteString("\n" + headerStyle.Width(m.frameWidth*3-2).Render(metricsHeaderText) + "\n")
+	content.WriteString(metricsFrameStyle.Render(metricsContent))
+	
+	return content.String()
+}
+
+// metricsTarget returns the resource whose history the metrics frame shows
+func (m Model) metricsTarget() *K8sResource {
+	if m.currentFrame == ResourceFrame && m.cursor < len(m.resources) {
+		return &m.resources[m.cursor]
+	}
+	if m.selectedK8sResource != nil {
+		return m.selectedK8sResource
+	}
+	if m.cursor < len(m.resources) {
+		return &m.resources[m.cursor]
+	}
+	return nil
+}
+
+// Log and event loading functions
+
+// loadLogs creates a command to asynchronously load logs for selected resource
+func (m Model) loadLogs() tea.Cmd {
+	return func() tea.Msg {
+		if m.selectedK8sResource == nil {
+			return logsLoadedMsg{err: fmt.Errorf("no resource selected")}
+		}
+
+		// Only pods support logs currently
+		if m.selectedK8sResource.ResourceType != PodsResource {
+			return logsLoadedMsg{err: fmt.Errorf("logs not supported for this resource type")}
+		}
+
+		// Get pod logs with the configured defaults
+		settings := m.settings()
+		podLogOpts := corev1.PodLogOptions{
+			TailLines:    int64Ptr(settings.TailLines),
+			Follow:       false,
+			Timestamps:   settings.LogTimestamps,
+			SinceSeconds: int64Ptr(int64(settings.LogSince.Seconds())),
+		}
+
+		namespace, name := m.selectedK8sResource.Namespace, m.selectedK8sResource.Name
+		var podLogs io.Reader
+		var container string
+		var containers []string
+		if m.replay != nil {
+			// Only logs loaded while recording, or collected in the dump, were kept
+			text, ok := m.replay.podLogs(m.replayFrame, namespace, name)
+			if !ok {
+				return logsLoadedMsg{err: fmt.Errorf("no logs of this pod were recorded")}
+			}
+			podLogs = strings.NewReader(text)
+		} else {
+			pod, err := m.clientset.CoreV1().Pods(namespace).Get(m.ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return logsLoadedMsg{err: fmt.Errorf("failed to get pod: %v", err)}
+			}
+			containers, container = logContainers(pod, m.logContainer)
+			podLogOpts.Container = container
+			req := m.clientset.CoreV1().Pods(namespace).GetLogs(name, &podLogOpts)
+			stream, err := req.Stream(m.ctx)
+			if err != nil {
+				return logsLoadedMsg{err: fmt.Errorf("failed to get logs: %v", err)}
+			}
+			defer stream.Close()
+			podLogs = stream
+		}
+
+		var logs []LogEntry
+		var raw strings.Builder
+		scanner := bufio.NewScanner(podLogs)
+		
+		for scanner.Scan() {
+			line := scanner.Text()
+			raw.WriteString(line + "\n")
+			if line == "" {
+				continue
+			}
+			
+			// Parse timestamp if present
+			timestamp := time.Now()
+			message := line
+			level := "INFO"
+			
+			// Try to parse Kubernetes log format
+			if strings.Contains(line, " ") {
+				parts := strings.SplitN(line, " ", 2)
+				if len(parts) == 2 {
+					if parsedTime, err := time.Parse(time.RFC3339, parts[0]); err == nil {
+						timestamp = parsedTime
+						message = parts[1]
+					}
+				}
+			}
+			
+			// Detect log level from whole words, so "stderr" or "interrupt" are no errors
+			for _, pattern := range logLevelPatterns {
+				if pattern.re.MatchString(message) {
+					level = pattern.level
+					break
+				}
+			}
+			
+			logs = append(logs, LogEntry{
+				Timestamp: timestamp,
+				Message:   message,
+				Container: container,
+				Level:     level,
+			})
+		}
+
+		if err := scanner.Err(); err != nil {
+			return logsLoadedMsg{err: fmt.Errorf("error reading logs: %v", err)}
+		}
+
+		if len(logs) == 0 {
+			return logsLoadedMsg{logs: []LogEntry{{
+				Timestamp: time.Now(),
+				Message:   "No logs available for this pod",
+				Container: "system",
+				Level:     "INFO",
+			}}, pod: namespace + "/" + name, container: container, containers: containers, raw: raw.String()}
+		}
+
+		return logsLoadedMsg{logs: logs, pod: namespace + "/" + name, container: container, containers: containers, raw: raw.String()}
+	}
+}
+
+// logLevelPatterns detect the level of a log line, checked in order
+var logLevelPatterns = []struct {
+	level string
+	re    *regexp.Regexp
+}{
+	{"ERROR", regexp.MustCompile(`(?i)\berr(or|ors)?\b`)},
+	{"WARN", regexp.MustCompile(`(?i)\bwarn(ing|ings)?\b`)},
+	{"DEBUG", regexp.MustCompile(`(?i)\bdebug\b`)},
+}
+
+// logContainers returns the containers of a pod and the one to read logs from: preferred if the pod has it,
+// else the kubectl.kubernetes.io/default-container annotation, else the first container
+func logContainers(pod *corev1.Pod, preferred string) ([]string, string) {
+	var names []string
+	for _, c := range pod.Spec.Containers {
+		names = append(names, c.Name)
+	}
+	if len(names) == 0 {
+		return nil, ""
+	}
+	if containsString(names, preferred) {
+		return names, preferred
+	}
+	if def := pod.Annotations["kubectl.kubernetes.io/default-container"]; containsString(names, def) {
+		return names, def
+	}
+	return names, names[0]
+}
+
+func (m Model) loadEventsCmd() tea.Cmd {
+	return func() tea.Msg {
+		var events []EventEntry
+		
+		if m.selectedK8sResource == nil {
+			return eventsLoadedMsg{events: events, err: nil}
+		}
+		
+		// Load events related to the selected resource, in its own namespace
+		eventList, err := m.clientset.CoreV1().Events(m.selectedK8sResource.Namespace).List(m.ctx, metav1.ListOptions{
+			FieldSelector: fmt.Sprintf("involvedObject.name=%s", m.selectedK8sResource.Name),
+		})
+		
+		if err != nil {
+			return eventsLoadedMsg{events: events, err: err}
+		}
+		
+		// Convert Kubernetes events to our EventEntry format
+		for _, event := range eventList.Items {
+			entry := EventEntry{
+				Timestamp: event.CreationTimestamp.Time,
+				Type:      event.Type,
+				Reason:    event.Reason,
+				Message:   event.Message,
+				Source:    event.Source.Component,
+				Count:     event.Count,
+			}
+			events = append(events, entry)
+		}
+		
+		// Sort events by timestamp (newest first)
+		sort.Slice(events, func(i, j int) bool {
+			return events[i].Timestamp.After(events[j].Timestamp)
+		})
+		
+		return eventsLoadedMsg{events: events, err: nil}
+	}
+}
+// renderLogs creates the enhanced logs view with color-coding
+func (m Model) renderLogs() string {
+	if m.selectedK8sResource == nil {
+		return "No resource selected for logs"
+	}
+	
+	var content strings.Builder
+	headerStyle := lipgloss.NewStyle().Foreground(colors.Success).Bold(true)
+	content.WriteString(headerStyle.Render(fmt.Sprintf("📋 Logs for %s '%s':", 
+		m.selectedK8sResource.ResourceType.String(), m.selectedK8sResource.Name)) + "\n")
+	if m.logContainer != "" {
+		containerLine := fmt.Sprintf("Container: %s", m.logContainer)
+		if len(m.logContainers) > 1 {
+			containerLine += fmt.Sprintf(" (one of %s; press '%s' for the next)",
+				strings.Join(m.logContainers, ", "), m.keyHint(actionContainer))
+		}
+		content.WriteString(lipgloss.NewStyle().Foreground(colors.Muted).Render(containerLine) + "\n")
+	}
+	content.WriteString("\n")
+	
+	if len(m.logEntries) == 0 {
+		content.WriteString("No logs available or logs loading...\n")
+		return content.String()
+	}
+	
+	// Calculate visible area for logs
+	visibleLines := m.height - 10
+	if visibleLines < 5 {
+		visibleLines = 5
+	}
+	
+	// Show scroll position indicator
+	totalLines := len(m.logEntries)
+	if totalLines > visibleLines {
+		infoStyle := lipgloss.NewStyle().Foreground(colors.Info)
+		content.WriteString(infoStyle.Render(fmt.Sprintf("Showing lines %d-%d of %d (scroll with ↑/↓)",
+			m.logScrollOffset+1,
+			min(m.logScrollOffset+visibleLines, totalLines),
+			totalLines)) + "\n\n")
+	} else {
+		infoStyle := lipgloss.NewStyle().Foreground(colors.Info)
+		content.WriteString(infoStyle.Render(fmt.Sprintf("Showing all %d log lines:", totalLines)) + "\n\n")
+	}
+	
+	// Render visible log lines with color coding
+	start := m.logScrollOffset
+	end := min(start+visibleLines, len(m.logEntries))
+	
+	for i := start; i < end; i++ {
+		logLine := m.logEntries[i]
+		
+		// Choose color based on log level
+		var logStyle lipgloss.Style
+		switch logLine.Level {
+		case "ERROR":
+			logStyle = lipgloss.NewStyle().Foreground(colors.Error)
+		case "WARN":
+			logStyle = lipgloss.NewStyle().Foreground(colors.Warning)
+		case "DEBUG":
+			logStyle = lipgloss.NewStyle().Foreground(colors.Muted)
+		default:
+			logStyle = lipgloss.NewStyle().Foreground(colors.Text)
+		}
+		
+		// Format timestamp
+		timeStyle := lipgloss.NewStyle().Foreground(colors.Secondary)
+		levelStyle := lipgloss.NewStyle().Foreground(colors.Info).Bold(true)
+		
+		line := fmt.Sprintf("%s [%s] %s", 
+			timeStyle.Render(logLine.Timestamp.Format("15:04:05")),
+			levelStyle.Render(logLine.Level),
+			logLine.Message)
+		content.WriteString(logStyle.Render(line) + "\n")
+	}
+	
+	return content.String()
+}
+
+func (m Model) renderEvents() string {
+	var content strings.Builder
+	
+	// Header
+	headerText := "📢 Events"
+	if m.selectedK8sResource != nil {
+		headerText = fmt.Sprintf("📢 Events - %s", m.selectedK8sResource.Name)
+	}
+	
+	headerStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colors.Success).
+		Margin(0, 0, 1, 0)
+	
+	content.WriteString(headerStyle.Render(headerText) + "\n")
+	
+	if m.selectedK8sResource == nil {
+		content.WriteString("No resource selected for events\n")
+		return content.String()
+	}
+	
+	if len(m.eventEntries) == 0 {
+		if m.loading {
+			content.WriteString("Loading events...\n")
+		} else {
+			content.WriteString("No events found for this resource\n")
+		}
+		return content.String()
+	}
+	
+	// Calculate visible range based on scroll offset
+	maxVisible := m.height - 10
+	if maxVisible < 5 {
+		maxVisible = 5
+	}
+	
+	startIdx := m.eventScrollOffset
+	endIdx := min(startIdx + maxVisible, len(m.eventEntries))
+	
+	// Render events
+	for i := startIdx; i < endIdx; i++ {
+		event := m.eventEntries[i]
+		
+		// Format timestamp
+		timestamp := event.Timestamp.Format("15:04:05")
+		
+		// Choose color based on event type
+		var eventStyle lipgloss.Style
+		switch event.Type {
+		case "Warning":
+			eventStyle = lipgloss.NewStyle().Foreground(colors.Warning)
+		case "Normal":
+			eventStyle = lipgloss.NewStyle().Foreground(colors.Success)
+		default:
+			eventStyle = lipgloss.NewStyle().Foreground(colors.Info)
+		}
+		
+		// Format event entry
+		eventLine := fmt.Sprintf("[%s] %s: %s", timestamp, event.Reason, event.Message)
+		if event.Count > 1 {
+			eventLine = fmt.Sprintf("[%s] %s: %s (x%d)", timestamp, event.Reason, event.Message, event.Count)
+		}
+		
+		content.WriteString(eventStyle.Render(eventLine) + "\n")
+	}
+	
+	// Show scroll indicator if there are more events
+	if len(m.eventEntries) > maxVisible {
+		scrollInfo := fmt.Sprintf("Showing %d-%d of %d events", startIdx+1, endIdx, len(m.eventEntries))
+		scrollStyle := lipgloss.NewStyle().Foreground(colors.Muted).Italic(true)
+		content.WriteString("\n" + scrollStyle.Render(scrollInfo) + "\n")
+	}
+	
+	return content.String()
+}
+
+// HealthRule is a named check evaluated over the live object of a resource.
+// Expr is a boolean expression over the object's fields, e.g.
+// "sum(status.containerStatuses[*].restartCount) > 5"; the rule fires when it is true.
+type HealthRule struct {
+	Name     string   `json:"name"`
+	Kinds    []string `json:"kinds"`    // Lower-case plural resource names ("pods", "deployments") or "*"
+	Severity string   `json:"severity"` // "error" or "warning"
+	Expr     string   `json:"expr"`
+	Message  string   `json:"message"` // text/template; {{.Name}}, {{.Namespace}} and {{.Eval "expr"}} are available
+	Disabled bool     `json:"disabled"`
+	
+	expr    ruleNode
+	message *template.Template
+}
+
+// RuleFinding records a rule that fired for a resource
+type RuleFinding struct {
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// RuleSet is the ordered set of active health rules
+type RuleSet struct {
+	rules []*HealthRule
+}
+
+// rulesFile is the layout of a user rules file
+type rulesFile struct {
+	Rules []HealthRule `json:"rules"`
+}
+
+// builtinRules is the default rule set; user rules with the same name replace or disable them
+var builtinRules = []HealthRule{
+	{
+		Name: "pod-not-ready", Kinds: []string{"pods"}, Severity: "error",
+		Expr:    "status.phase != 'Succeeded' && status.conditions[type == 'Ready'].status == 'False'",
+		Message: "Pod is not ready",
+	},
+	{
+		Name: "pod-unschedulable", Kinds: []string{"pods"}, Severity: "error",
+		Expr:    "status.conditions[type == 'PodScheduled' && status == 'False'].reason == 'Unschedulable'",
+		Message: "Pod cannot be scheduled",
+	},
+	{
+		// Reasons with a rule of their own are left to it
+		Name: "pod-container-waiting", Kinds: []string{"pods"}, Severity: "warning",
+		Expr:    "exists(status.containerStatuses[exists(state.waiting) && !(state.waiting.reason =~ '^(CrashLoopBackOff|ErrImagePull|ImagePullBackOff|InvalidImageName)$')])",
+		Message: `Container {{.Eval "status.containerStatuses[exists(state.waiting) && !(state.waiting.reason =~ '^(CrashLoopBackOff|ErrImagePull|ImagePullBackOff|InvalidImageName)$')].name"}} is waiting: {{.Eval "status.containerStatuses[exists(state.waiting) && !(state.waiting.reason =~ '^(CrashLoopBackOff|ErrImagePull|ImagePullBackOff|InvalidImageName)$')].state.waiting.reason"}}`,
+	},
+	{
+		Name: "pod-container-failed", Kinds: []string{"pods"}, Severity: "error",
+		Expr:    "status.containerStatuses[*].state.terminated.exitCode != 0",
+		Message: `Container {{.Eval "status.containerStatuses[state.terminated.exitCode != 0].name"}} terminated with exit code {{.Eval "status.containerStatuses[state.terminated.exitCode != 0].state.terminated.exitCode"}}`,
+	},
+	{
+		// Up to the pod-high-restarts threshold; above it that rule reports the count
+		Name: "pod-restarted", Kinds: []string{"pods"}, Severity: "warning",
+		Expr:    "sum(status.containerStatuses[*].restartCount) > 0 && sum(status.containerStatuses[*].restartCount) <= 5",
+		Message: `Container(s) restarted {{.Eval "sum(status.containerStatuses[*].restartCount)"}} times`,
+	},
+	{
+		Name: "pod-high-restarts", Kinds: []string{"pods"}, Severity: "warning",
+		Expr:    "sum(status.containerStatuses[*].restartCount) > 5",
+		Message: `High restart count: {{.Eval "sum(status.containerStatuses[*].restartCount)"}}`,
+	},
+	{
+		Name: "pod-crashloop", Kinds: []string{"pods"}, Severity: "error",
+		Expr:    "status.containerStatuses[*].state.waiting.reason == 'CrashLoopBackOff'",
+		Message: "Container is in CrashLoopBackOff",
+	},
+	{
+		Name: "pod-image-pull", Kinds: []string{"pods"}, Severity: "error",
+		Expr:    "status.containerStatuses[*].state.waiting.reason =~ '^(ErrImagePull|ImagePullBackOff|InvalidImageName)$'",
+		Message: "Image cannot be pulled",
+	},
+	{
+		Name: "pod-oom-killed", Kinds: []string{"pods"}, Severity: "warning",
+		Expr:    "status.containerStatuses[*].lastState.terminated.reason == 'OOMKilled'",
+		Message: "Container was OOMKilled",
+	},
+	{
+		Name: "pod-pending-too-long", Kinds: []string{"pods"}, Severity: "warning",
+		Expr:    "status.phase == 'Pending' && age() > 300",
+		Message: "Pod has been pending for more than 5 minutes",
+	},
+	{
+		// Near the memory limit the container is about to be OOM-killed
+		Name: "pod-memory-near-limit", Kinds: []string{"pods"}, Severity: "warning",
+		Expr:    "exists(usage.containers[memoryLimitPercent >= 90])",
+		Message: `Container {{.Eval "usage.containers[memoryLimitPercent >= 90].name"}} memory at {{.Eval "usage.containers[memoryLimitPercent >= 90].memoryLimitPercent"}}% of limit`,
+	},
+	{
+		Name: "pod-cpu-near-limit", Kinds: []string{"pods"}, Severity: "warning",
+		Expr:    "exists(usage.containers[cpuLimitPercent >= 90])",
+		Message: `Container {{.Eval "usage.containers[cpuLimitPercent >= 90].name"}} CPU at {{.Eval "usage.containers[cpuLimitPercent >= 90].cpuLimitPercent"}}% of limit (throttling likely)`,
+	},
+	{
+		Name: "image-latest-tag", Kinds: []string{"pods"}, Severity: "warning",
+		Expr:    "spec.containers[*].image =~ ':latest$'",
+		Message: "Container image uses the mutable :latest tag",
+	},
+	{
+		Name: "deployment-unavailable", Kinds: []string{"deployments"}, Severity: "error",
+		Expr:    "status.conditions[type == 'Available'].status != 'True'",
+		Message: "Deployment not available",
+	},
+	{
+		Name: "deployment-replicas-not-ready", Kinds: []string{"deployments"}, Severity: "warning",
+		Expr:    "default(status.readyReplicas, 0) != default(status.replicas, 0)",
+		Message: "Not all replicas are ready",
+	},
+	{
+		Name: "deployment-progress-deadline", Kinds: []string{"deployments"}, Severity: "error",
+		Expr:    "status.conditions[*].reason == 'ProgressDeadlineExceeded'",
+		Message: "Rollout exceeded its progress deadline",
+	},
+	{
+		Name: "replicaset-replicas-not-ready", Kinds: []string{"replicasets"}, Severity: "error",
+		Expr:    "default(status.readyReplicas, 0) != default(status.replicas, 0)",
+		Message: "Not all replicas are ready",
+	},
+	{
+		Name: "statefulset-replicas-not-ready", Kinds: []string{"statefulsets"}, Severity: "error",
+		Expr:    "default(status.readyReplicas, 0) != default(status.replicas, 0)",
+		Message: "Not all replicas are ready",
+	},
+	{
+		Name: "deploymentconfig-replicas-not-ready", Kinds: []string{"deploymentconfigs"}, Severity: "error",
+		Expr:    "default(status.readyReplicas, 0) != default(status.replicas, 0)",
+		Message: "Not all replicas are ready",
+	},
+	{
+		Name: "daemonset-pods-not-ready", Kinds: []string{"daemonsets"}, Severity: "error",
+		Expr:    "default(status.numberReady, 0) != default(status.desiredNumberScheduled, 0)",
+		Message: "Not all pods are ready",
+	},
+	{
+		Name: "daemonset-misscheduled", Kinds: []string{"daemonsets"}, Severity: "warning",
+		Expr:    "status.numberMisscheduled > 0",
+		Message: `{{.Eval "status.numberMisscheduled"}} pod(s) running on nodes they should not`,
+	},
+	{
+		Name: "node-not-ready", Kinds: []string{"nodes"}, Severity: "error",
+		Expr:    "status.conditions[type == 'Ready'].status != 'True'",
+		Message: `Node is not ready: {{.Eval "status.conditions[type == 'Ready'].message"}}`,
+	},
+	{
+		Name: "node-pressure", Kinds: []string{"nodes"}, Severity: "warning",
+		Expr:    "exists(status.conditions[type =~ 'Pressure$' && status == 'True'])",
+		Message: `{{.Eval "status.conditions[type =~ 'Pressure$' && status == 'True'].type"}} detected`,
+	},
+	{
+		Name: "node-network-unavailable", Kinds: []string{"nodes"}, Severity: "error",
+		Expr:    "status.conditions[type == 'NetworkUnavailable'].status == 'True'",
+		Message: "Network unavailable",
+	},
+	{
+		Name: "node-cordoned", Kinds: []string{"nodes"}, Severity: "warning",
+		Expr:    "spec.unschedulable == true",
+		Message: "Node is cordoned (unschedulable)",
+	},
+	{
+		Name: "node-memory-high", Kinds: []string{"nodes"}, Severity: "warning",
+		Expr:    "usage.memoryPercent >= 90",
+		Message: `Memory usage at {{.Eval "usage.memoryPercent"}}% of allocatable`,
+	},
+	{
+		Name: "node-cpu-high", Kinds: []string{"nodes"}, Severity: "warning",
+		Expr:    "usage.cpuPercent >= 90",
+		Message: `CPU usage at {{.Eval "usage.cpuPercent"}}% of allocatable`,
+	},
+	{
+		Name: "loadbalancer-pending", Kinds: []string{"services"}, Severity: "warning",
+		Expr:    "spec.type == 'LoadBalancer' && len(status.loadBalancer.ingress) == 0",
+		Message: "LoadBalancer has no external address",
+	},
+	{
+		// endpoints is counted from the EndpointSlices; it is missing when they cannot be listed
+		Name: "service-no-endpoints", Kinds: []string{"services"}, Severity: "warning",
+		Expr:    "len(spec.selector) > 0 && endpoints.ready == 0",
+		Message: "Service has no ready endpoints",
+	},
+	{
+		Name: "ingress-no-address", Kinds: []string{"ingresses"}, Severity: "warning",
+		Expr:    "!exists(status.loadBalancer.ingress[*].ip) && !exists(status.loadBalancer.ingress[*].hostname)",
+		Message: "Ingress has no load balancer address",
+	},
+	{
+		Name: "pvc-pending", Kinds: []string{"persistentvolumeclaims"}, Severity: "error",
+		Expr:    "status.phase == 'Pending'",
+		Message: "PVC is stuck in pending state",
+	},
+	{
+		Name: "job-failed-pods", Kinds: []string{"jobs"}, Severity: "error",
+		Expr:    "status.failed > 0 && default(status.succeeded, 0) < default(spec.completions, 1)",
+		Message: `Job has {{.Eval "status.failed"}} failed pods`,
+	},
+	{
+		Name: "job-failed", Kinds: []string{"jobs"}, Severity: "error",
+		Expr:    "status.conditions[type == 'Failed'].status == 'True'",
+		Message: `Job failed: {{.Eval "status.conditions[type == 'Failed' && status == 'True'].reason"}}`,
+	},
+	{
+		Name: "job-suspended", Kinds: []string{"jobs"}, Severity: "warning",
+		Expr:    "status.conditions[type == 'Suspended'].status == 'True'",
+		Message: "Job is suspended",
+	},
+	{
+		// nextRun.error is set when the schedule cannot be parsed
+		Name: "cronjob-invalid-schedule", Kinds: []string{"cronjobs"}, Severity: "error",
+		Expr:    "exists(nextRun.error)",
+		Message: `Cannot compute next run: {{.Eval "nextRun.error"}}`,
+	},
+	{
+		// The last scheduled run finished after the last success: it failed
+		Name: "cronjob-last-run-failed", Kinds: []string{"cronjobs"}, Severity: "warning",
+		Expr:    "exists(status.lastScheduleTime) && len(status.active) == 0 && default(status.lastSuccessfulTime, '') < status.lastScheduleTime",
+		Message: "Last scheduled run did not succeed",
+	},
+	{
+		Name: "route-not-admitted", Kinds: []string{"routes"}, Severity: "error",
+		Expr:    "status.ingress[*].conditions[type == 'Admitted'].status != 'True'",
+		Message: `Route not admitted: {{.Eval "status.ingress[*].conditions[type == 'Admitted' && status != 'True'].message"}}`,
+	},
+	{
+		Name: "project-not-active", Kinds: []string{"projects"}, Severity: "error",
+		Expr:    "status.phase != 'Active'",
+		Message: `Project is in {{.Eval "status.phase"}} state`,
+	},
+}
+
+// ConfigSettings are the preferences that can be set globally and overridden per context
+type ConfigSettings struct {
+	RefreshInterval  string              `json:"refreshInterval,omitempty"`  // e.g. 5s
+	AutoRefresh      *bool               `json:"autoRefresh,omitempty"`
+	DefaultNamespace string              `json:"defaultNamespace,omitempty"` // Preselected namespace, "all" for all namespaces
+	Logs             LogConfig           `json:"logs,omitempty"`
+	Resources        []string            `json:"resources,omitempty"`        // Resource types shown in the menus, e.g. [pods, deploy]
+	Columns          map[string][]string `json:"columns,omitempty"`          // Table columns per kind, e.g. pods: [NAME, READY, STATUS]
+	Theme            string              `json:"theme,omitempty"`            // auto, dark, light, high-contrast or a custom theme
+}
+
+// LogConfig holds the defaults for fetching pod logs
+type LogConfig struct {
+	TailLines  *int64 `json:"tailLines,omitempty"`
+	Since      string `json:"since,omitempty"` // e.g. 1h
+	Timestamps *bool  `json:"timestamps,omitempty"`
+}
+
+// AppConfig is the content of ~/.config/k8sgo/config.yaml
+type AppConfig struct {
+	ConfigSettings
+	DefaultContext string                         `json:"defaultContext,omitempty"`
+	Contexts       map[string]ConfigSettings      `json:"contexts,omitempty"` // Overrides per kubeconfig context
+	Themes         map[string]ThemeConfig         `json:"themes,omitempty"`   // Custom themes by name
+	Keys           map[string]map[string][]string `json:"keys,omitempty"`     // Key overrides by "global" or view name, then action
+	Notifications  NotificationConfig             `json:"notifications,omitempty"`
+	
+	path   string // File the config was read from
+	loaded bool   // False when the file does not exist and defaults apply
+}
+
+// EffectiveConfig is the configuration in force for one context, with defaults filled in
+type EffectiveConfig struct {
+	Context          string
+	RefreshInterval  time.Duration
+	AutoRefresh      bool
+	DefaultNamespace string
+	TailLines        int64
+	LogSince         time.Duration
+	LogTimestamps    bool
+	Resources        map[ResourceType]bool // nil when every resource type is enabled
+	Columns          map[ResourceType][]string
+	Theme            string
+}
+
+// configFilePath returns the config file: $K8SGO_CONFIG or ~/.config/k8sgo/config.yaml
+func configFilePath() string {
+	if path := os.Getenv("K8SGO_CONFIG"); path != "" {
+		return path
+	}
+	return filepath.Join(homedir.HomeDir(), ".config", "k8sgo", "config.yaml")
+}
+
+// loadConfig reads and validates the config file; a missing file yields the defaults
+func loadConfig(path string) (*AppConfig, error) {
+	cfg := &AppConfig{path: path}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	cfg.loaded = true
+	
+	problems := cfg.ConfigSettings.validate("")
+	contexts := kubeconfigContexts()
+	if cfg.DefaultContext != "" && contexts != nil && !containsString(contexts, cfg.DefaultContext) {
+		problems = append(problems, fmt.Sprintf("defaultContext: context %q not found in kubeconfig", cfg.DefaultContext))
+	}
+	names := make([]string, 0, len(cfg.Contexts))
+	for name := range cfg.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		problems = append(problems, cfg.Contexts[name].validate(fmt.Sprintf("contexts.%s.", name))...)
+	}
+	
+	themes := cfg.themeNames()
+	for _, name := range themes[len(builtinThemeNames())+1:] {
+		problems = append(problems, cfg.Themes[name].validate(name)...)
+	}
+	for _, name := range append(builtinThemeNames(), defaultTheme) {
+		if _, ok := cfg.Themes[name]; ok {
+			problems = append(problems, fmt.Sprintf("themes.%s: cannot redefine the %s theme; give the custom theme another name", name, name))
+		}
+	}
+	_, keyProblems := buildKeymap(cfg.Keys)
+	problems = append(problems, keyProblems...)
+	problems = append(problems, cfg.Notifications.validate()...)
+	if cfg.Theme != "" && !containsString(themes, cfg.Theme) {
+		problems = append(problems, fmt.Sprintf("theme: unknown theme %q (available: %s)", cfg.Theme, strings.Join(themes, ", ")))
+	}
+	for _, name := range names {
+		if theme := cfg.Contexts[name].Theme; theme != "" && !containsString(themes, theme) {
+			problems = append(problems, fmt.Sprintf("contexts.%s.theme: unknown theme %q (available: %s)", name, theme, strings.Join(themes, ", ")))
+		}
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("%s:\n  %s", path, strings.Join(problems, "\n  "))
+	}
+	return cfg, nil
+}
+
+// kubeconfigContexts returns the context names in the kubeconfig, or nil if it cannot be read
+func kubeconfigContexts() []string {
+	config, err := clientcmd.NewDefaultClientConfigLoadingRules().Load()
+	if err != nil {
+		return nil
+	}
+	var contexts []string
+	for name := range config.Contexts {
+		contexts = append(contexts, name)
+	}
+	return contexts
+}
+
+// validate returns the problems of a settings block; prefix is its path in the file
+func (s ConfigSettings) validate(prefix string) []string {
+	var problems []string
+	if s.RefreshInterval != "" {
+		if d, err := time.ParseDuration(s.RefreshInterval); err != nil || d < time.Second {
+			problems = append(problems, fmt.Sprintf("%srefreshInterval: %q is not a duration of at least 1s (e.g. 5s, 1m)", prefix, s.RefreshInterval))
+		}
+	}
+	if s.Logs.TailLines != nil && *s.Logs.TailLines <= 0 {
+		problems = append(problems, fmt.Sprintf("%slogs.tailLines: must be positive, got %d", prefix, *s.Logs.TailLines))
+	}
+	if s.Logs.Since != "" {
+		if d, err := time.ParseDuration(s.Logs.Since); err != nil || d <= 0 {
+			problems = append(problems, fmt.Sprintf("%slogs.since: %q is not a positive duration (e.g. 30m, 2h)", prefix, s.Logs.Since))
+		}
+	}
+	
+	aliases := staticKindAliases()
+	for _, name := range s.Resources {
+		if _, ok := aliases[strings.ToLower(name)]; !ok {
+			problems = append(problems, fmt.Sprintf("%sresources: unknown resource type %q", prefix, name))
+		}
+	}
+	kinds := make([]string, 0, len(s.Columns))
+	for kind := range s.Columns {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		rt, ok := aliases[strings.ToLower(kind)]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%scolumns: unknown resource type %q", prefix, kind))
+			continue
+		}
+		// Every column a kind can show, including NAMESPACE for all-namespaces lists
+		var titles []string
+		for _, col := range (Model{}).availableColumns(rt) {
+			titles = append(titles, col.Title)
+		}
+		for _, title := range s.Columns[kind] {
+			if !containsString(titles, strings.ToUpper(title)) {
+				problems = append(problems, fmt.Sprintf("%scolumns.%s: unknown column %q (available: %s)", prefix, kind, title, strings.Join(titles, ", ")))
+			}
+		}
+	}
+	return problems
+}
+
+// effective merges the defaults, the global settings and the overrides for a context
+func (c *AppConfig) effective(context string) EffectiveConfig {
+	eff := EffectiveConfig{
+		Context:         context,
+		Theme:           defaultTheme,
+		RefreshInterval: 5 * time.Second,
+		AutoRefresh:     true,
+		TailLines:       200,
+		LogSince:        time.Hour,
+		LogTimestamps:   true,
+	}
+	if c == nil {
+		return eff
+	}
+	
+	layers := []ConfigSettings{c.ConfigSettings}
+	if override, ok := c.Contexts[context]; ok {
+		layers = append(layers, override)
+	}
+	aliases := staticKindAliases()
+	for _, s := range layers {
+		if d, err := time.ParseDuration(s.RefreshInterval); err == nil {
+			eff.RefreshInterval = d
+		}
+		if s.AutoRefresh != nil {
+			eff.AutoRefresh = *s.AutoRefresh
+		}
+		if s.DefaultNamespace != "" {
+			eff.DefaultNamespace = s.DefaultNamespace
+		}
+		if s.Theme != "" {
+			eff.Theme = s.Theme
+		}
+		if s.Logs.TailLines != nil {
+			eff.TailLines = *s.Logs.TailLines
+		}
+		if d, err := time.ParseDuration(s.Logs.Since); err == nil {
+			eff.LogSince = d
+		}
+		if s.Logs.Timestamps != nil {
+			eff.LogTimestamps = *s.Logs.Timestamps
+		}
+		if len(s.Resources) > 0 {
+			eff.Resources = map[ResourceType]bool{}
+			for _, name := range s.Resources {
+				eff.Resources[aliases[strings.ToLower(name)]] = true
+			}
+		}
+		for kind, titles := range s.Columns {
+			if eff.Columns == nil {
+				eff.Columns = map[ResourceType][]string{}
+			}
+			upper := make([]string, len(titles))
+			for i, title := range titles {
+				upper[i] = strings.ToUpper(title)
+			}
+			eff.Columns[aliases[strings.ToLower(kind)]] = upper
+		}
+	}
+	return eff
+}
+
+// ThemeConfig defines a custom theme; colors left empty come from the base theme
+type ThemeConfig struct {
+	Base       string `json:"base,omitempty"` // Built-in theme to start from, dark when empty
+	Primary    string `json:"primary,omitempty"`
+	Secondary  string `json:"secondary,omitempty"`
+	Success    string `json:"success,omitempty"`
+	Warning    string `json:"warning,omitempty"`
+	Error      string `json:"error,omitempty"`
+	Info       string `json:"info,omitempty"`
+	Accent     string `json:"accent,omitempty"`
+	Background string `json:"background,omitempty"`
+	Text       string `json:"text,omitempty"`
+	Muted      string `json:"muted,omitempty"`
+}
+
+// colorPattern matches the colors lipgloss understands: #rgb, #rrggbb or an ANSI color number
+var colorPattern = regexp.MustCompile(`^(#[0-9a-fA-F]{3}|#[0-9a-fA-F]{6}|[0-9]{1,3})$`)
+
+// validate returns the problems of a custom theme definition
+func (t ThemeConfig) validate(name string) []string {
+	var problems []string
+	if t.Base != "" {
+		if _, ok := builtinThemes[t.Base]; !ok {
+			problems = append(problems, fmt.Sprintf("themes.%s.base: unknown theme %q (built-in: %s)", name, t.Base, strings.Join(builtinThemeNames(), ", ")))
+		}
+	}
+	values := map[string]string{
+		"primary": t.Primary, "secondary": t.Secondary, "success": t.Success, "warning": t.Warning, "error": t.Error,
+		"info": t.Info, "accent": t.Accent, "background": t.Background, "text": t.Text, "muted": t.Muted,
+	}
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := values[key]
+		if value == "" {
+			continue
+		}
+		if n, err := strconv.Atoi(value); !colorPattern.MatchString(value) || (err == nil && n > 255) {
+			problems = append(problems, fmt.Sprintf("themes.%s.%s: %q is not a color (use #rrggbb, #rgb or an ANSI number 0-255)", name, key, value))
+		}
+	}
+	return problems
+}
+
+// scheme builds the color scheme of a custom theme on top of its base
+func (t ThemeConfig) scheme() ColorScheme {
+	base := builtinThemes["dark"]
+	if scheme, ok := builtinThemes[t.Base]; ok {
+		base = scheme
+	}
+	set := func(dst *lipgloss.Color, value string) {
+		if value != "" {
+			*dst = lipgloss.Color(value)
+		}
+	}
+	set(&base.Primary, t.Primary)
+	set(&base.Secondary, t.Secondary)
+	set(&base.Success, t.Success)
+	set(&base.Warning, t.Warning)
+	set(&base.Error, t.Error)
+	set(&base.Info, t.Info)
+	set(&base.Accent, t.Accent)
+	set(&base.Background, t.Background)
+	set(&base.Text, t.Text)
+	set(&base.Muted, t.Muted)
+	return base
+}
+
+// builtinThemeNames returns the names of the built-in themes in display order
+func builtinThemeNames() []string {
+	return []string{"dark", "light", "high-contrast"}
+}
+
+// themeNames returns every theme that can be selected: auto, the built-in themes, then custom themes
+func (c *AppConfig) themeNames() []string {
+	names := append([]string{defaultTheme}, builtinThemeNames()...)
+	if c == nil {
+		return names
+	}
+	var custom []string
+	for name := range c.Themes {
+		if _, ok := builtinThemes[name]; !ok && name != defaultTheme {
+			custom = append(custom, name)
+		}
+	}
+	sort.Strings(custom)
+	return append(names, custom...)
+}
+
+// themeScheme resolves a theme name to its colors; auto picks dark or light from the terminal background
+func (c *AppConfig) themeScheme(name string) (ColorScheme, bool) {
+	if c != nil {
+		if theme, ok := c.Themes[name]; ok {
+			return theme.scheme(), true
+		}
+	}
+	if name == defaultTheme || name == "" {
+		if lipgloss.HasDarkBackground() {
+			return builtinThemes["dark"], true
+		}
+		return builtinThemes["light"], true
+	}
+	scheme, ok := builtinThemes[name]
+	return scheme, ok
+}
+
+// activeTheme returns the theme chosen at runtime, or the configured one for the current context
+func (m Model) activeTheme() string {
+	if m.themeOverride != "" {
+		return m.themeOverride
+	}
+	return m.settings().Theme
+}
+
+// applyTheme makes the active theme the one every render function draws with
+func (m Model) applyTheme() {
+	if scheme, ok := m.config.themeScheme(m.activeTheme()); ok {
+		colors = scheme
+	}
+}
+
+// switchTheme selects a theme for the rest of the session; an empty name cycles to the next one
+func (m Model) switchTheme(name string) Model {
+	if name == "" {
+		names := m.config.themeNames()
+		next := 0
+		for i, theme := range names {
+			if theme == m.activeTheme() {
+				next = (i + 1) % len(names)
+			}
+		}
+		name = names[next]
+	}
+	m.themeOverride = name
+	m.applyTheme()
+	m.statusMessage = fmt.Sprintf("Theme: %s", name)
+	return m
+}
+
+// settings returns the configuration in force for the selected context
+func (m Model) settings() EffectiveConfig {
+	eff := m.config.effective(m.selectedKubeContext)
+	if m.refreshOverride > 0 {
+		eff.RefreshInterval = m.refreshOverride
+	}
+	return eff
+}
+
+// configNamespace converts a configured default namespace to selectedNamespace ("all" lists all namespaces)
+func configNamespace(namespace string) string {
+	if namespace == "all" {
+		return metav1.NamespaceAll
+	}
+	return namespace
+}
+
+// enabledResourceTypes drops the resource types disabled in the config
+func (m Model) enabledResourceTypes(types []ResourceType) []ResourceType {
+	enabled := m.settings().Resources
+	if enabled == nil {
+		return types
+	}
+	var result []ResourceType
+	for _, rt := range types {
+		if enabled[rt] {
+			result = append(result, rt)
+		}
+	}
+	return result
+}
+
+// defaultNamespaceCursor returns the NamespaceView row of the configured default namespace, or 0
+func (m Model) defaultNamespaceCursor() int {
+	namespace := m.settings().DefaultNamespace
+	for i, ns := range m.namespaces {
+		if ns == namespace {
+			return i + 1 // Row 0 is "All namespaces"
+		}
+	}
+	return 0
+}
+
+// renderConfig shows the effective configuration for the selected context
+func (m Model) renderConfig() string {
+	var content strings.Builder
+	headerStyle := lipgloss.NewStyle().Foreground(colors.Success).Bold(true)
+	keyStyle := lipgloss.NewStyle().Foreground(colors.Info)
+	mutedStyle := lipgloss.NewStyle().Foreground(colors.Text).Faint(true).Italic(true)
+	
+	eff := m.settings()
+	content.WriteString(headerStyle.Render("⚙️  Effective configuration") + "\n\n")
+	
+	source := "built-in defaults"
+	if m.config != nil && m.config.loaded {
+		source = m.config.path
+	} else if m.config != nil {
+		source = fmt.Sprintf("built-in defaults (no file at %s)", m.config.path)
+	}
+	overridden := false
+	if m.config != nil {
+		_, overridden = m.config.Contexts[eff.Context]
+	}
+	
+	line := func(key, value string) {
+		content.WriteString(fmt.Sprintf("%s %s\n", keyStyle.Render(fmt.Sprintf("%-26s", key+":")), value))
+	}
+	line("Source", source)
+	if eff.Context != "" {
+		context := eff.Context
+		if overridden {
+			context += " (with context overrides)"
+		}
+		line("Context", context)
+	}
+	if m.config != nil && m.config.DefaultContext != "" {
+		line("Default context", m.config.DefaultContext)
+	}
+	line("Refresh interval", eff.RefreshInterval.String())
+	line("Auto-refresh", fmt.Sprintf("%t (currently %t)", eff.AutoRefresh, m.autoRefresh))
+	defaultNamespace := eff.DefaultNamespace
+	if defaultNamespace == "" {
+		defaultNamespace = "-"
+	}
+	line("Default namespace", defaultNamespace)
+	line("Log tail lines", strconv.FormatInt(eff.TailLines, 10))
+	line("Log since", eff.LogSince.String())
+	line("Log timestamps", strconv.FormatBool(eff.LogTimestamps))
+	theme := m.activeTheme()
+	if m.themeOverride != "" {
+		theme += fmt.Sprintf(" (chosen this session; configured: %s)", eff.Theme)
+	}
+	line("Theme", theme)
+	line("Available themes", strings.Join(m.config.themeNames(), ", "))
+	notifications := "off (no webhook)"
+	if m.config != nil && m.config.Notifications.enabled() {
+		cfg := m.config.Notifications
+		// Incoming webhook URLs embed their secret in the path, so only the host is shown
+		host := cfg.Webhook
+		if u, err := url.Parse(cfg.Webhook); err == nil {
+			host = u.Host
+		}
+		notifications = fmt.Sprintf("webhook on %s every %s, dedupe %s, at most %d/min", host, cfg.interval(), cfg.dedupeWindow(), cfg.rateLimit())
+	}
+	line("Notifications", notifications)
+	
+	var enabled []string
+	for _, rt := range append(m.clusterResourceTypes(), m.namespacedResourceTypes()...) {
+		enabled = append(enabled, rt.String())
+	}
+	if eff.Resources == nil {
+		line("Resource types", "all")
+	} else {
+		line("Resource types", strings.Join(enabled, ", "))
+	}
+	
+	content.WriteString("\n" + headerStyle.Render("Table columns") + "\n")
+	var kinds []ResourceType
+	for rt := range resourceKinds {
+		kinds = append(kinds, rt)
+	}
+	sort.Slice(kinds, func(i, j int) bool { return kinds[i] < kinds[j] })
+	for _, rt := range kinds {
+		var titles []string
+		for _, col := range m.resourceColumns(rt) {
+			titles = append(titles, col.Title)
+		}
+		suffix := ""
+		if _, ok := eff.Columns[rt]; ok {
+			suffix = " (configured)"
+		}
+		line(rt.String(), strings.Join(titles, " ")+suffix)
+	}
+	
+	if m.config != nil {
+		content.WriteString("\n" + mutedStyle.Render("Edit "+m.config.path+" and restart k8sGo to apply changes.") + "\n")
+	}
+	return content.String()
+}
+
+// Notification triggers
+const (
+	triggerCrashLoop    = "crashloop"     // A pod container enters CrashLoopBackOff
+	triggerNotReady     = "notready"      // A node's Ready condition stops being True
+	triggerWarningEvent = "warning-event" // A new or repeated Warning event
+)
+
+var notificationTriggers = []string{triggerCrashLoop, triggerNotReady, triggerWarningEvent}
+
+// defaultNotificationTemplate is accepted by Slack and Teams incoming webhooks
+const defaultNotificationTemplate = `{"text": {{json .Text}}}`
+
+// notificationHistoryLimit is the number of notifications kept for the history panel
+const notificationHistoryLimit = 200
+
+// NotificationConfig configures webhook notifications about state transitions
+type NotificationConfig struct {
+	Webhook      string            `json:"webhook,omitempty"`      // Incoming webhook URL; notifications are off without it
+	Template     string            `json:"template,omitempty"`     // text/template rendering the JSON body
+	Headers      map[string]string `json:"headers,omitempty"`      // Extra request headers, e.g. Authorization
+	Triggers     []string          `json:"triggers,omitempty"`     // crashloop, notready, warning-event; default all
+	Interval     string            `json:"interval,omitempty"`     // Time between polls, default 30s
+	DedupeWindow string            `json:"dedupeWindow,omitempty"` // The same resource and reason notify once per window, default 10m
+	RateLimit    int               `json:"rateLimit,omitempty"`    // Notifications sent per minute, default 6
+}
+
+// Notification is one state transition, as passed to the webhook template
+type Notification struct {
+	ID        int
+	Time      time.Time
+	Context   string
+	Trigger   string // crashloop, notready or warning-event
+	Kind      string
+	Namespace string
+	Name      string
+	Reason    string
+	Message   string
+	Text      string // One-line summary, e.g. Slack's "text"
+	
+	Status  string // sending, sent, failed, rate-limited (waiting to be sent) or dropped
+	Error   string // Why sending failed
+	Repeats int    // Duplicates suppressed within the dedupe window
+}
+
+// dedupeKey identifies notifications about the same resource and reason
+func (n Notification) dedupeKey() string {
+	return strings.Join([]string{n.Context, n.Trigger, n.Kind, n.Namespace, n.Name, n.Reason}, "/")
+}
+
+// enabled reports whether a webhook is configured
+func (c NotificationConfig) enabled() bool {
+	return c.Webhook != ""
+}
+
+// interval returns the time between polls
+func (c NotificationConfig) interval() time.Duration {
+	if d, err := time.ParseDuration(c.Interval); err == nil {
+		return d
+	}
+	return 30 * time.Second
+}
+
+// dedupeWindow returns how long the same notification is suppressed
+func (c NotificationConfig) dedupeWindow() time.Duration {
+	if d, err := time.ParseDuration(c.DedupeWindow); err == nil {
+		return d
+	}
+	return 10 * time.Minute
+}
+
+// rateLimit returns the notifications sent per minute
+func (c NotificationConfig) rateLimit() int {
+	if c.RateLimit > 0 {
+		return c.RateLimit
+	}
+	return 6
+}
+
+// triggered reports whether a trigger is enabled
+func (c NotificationConfig) triggered(trigger string) bool {
+	return len(c.Triggers) == 0 || containsString(c.Triggers, trigger)
+}
+
+// template parses the body template; json renders a value as JSON, e.g. {{json .Text}}
+func (c NotificationConfig) template() (*template.Template, error) {
+	text := c.Template
+	if text == "" {
+		text = defaultNotificationTemplate
+	}
+	return template.New("notification").Option("missingkey=error").Funcs(template.FuncMap{
+		"json": func(v interface{}) (string, error) {
+			data, err := json.Marshal(v)
+			return string(data), err
+		},
+	}).Parse(text)
+}
+
+// body renders the JSON request body of a notification
+func (c NotificationConfig) body(n Notification) ([]byte, error) {
+	tmpl, err := c.template()
+	if err != nil {
+		return nil, err
+	}
+	var body bytes.Buffer
+	if err := tmpl.Execute(&body, n); err != nil {
+		return nil, err
+	}
+	if !json.Valid(body.Bytes()) {
+		return nil, fmt.Errorf("template did not render valid JSON: %s", truncateString(body.String(), 80))
+	}
+	return body.Bytes(), nil
+}
+
+// validate returns the problems of the notifications block
+func (c NotificationConfig) validate() []string {
+	var problems []string
+	if c.Webhook != "" {
+		if u, err := url.Parse(c.Webhook); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			problems = append(problems, fmt.Sprintf("notifications.webhook: %q is not an http or https URL", c.Webhook))
+		}
+	}
+	if _, err := c.body(Notification{Time: time.Now(), Context: "example", Trigger: triggerCrashLoop, Kind: "Pod",
+		Namespace: "default", Name: "example", Reason: "CrashLoopBackOff", Message: `a "quoted" message`, Text: "example"}); err != nil {
+		problems = append(problems, fmt.Sprintf("notifications.template: %v", err))
+	}
+	for _, trigger := range c.Triggers {
+		if !containsString(notificationTriggers, trigger) {
+			problems = append(problems, fmt.Sprintf("notifications.triggers: unknown trigger %q (use %s)", trigger, strings.Join(notificationTriggers, ", ")))
+		}
+	}
+	if c.Interval != "" {
+		if d, err := time.ParseDuration(c.Interval); err != nil || d < 5*time.Second {
+			problems = append(problems, fmt.Sprintf("notifications.interval: %q is not a duration of at least 5s (e.g. 30s, 1m)", c.Interval))
+		}
+	}
+	if c.DedupeWindow != "" {
+		if d, err := time.ParseDuration(c.DedupeWindow); err != nil || d < 0 {
+			problems = append(problems, fmt.Sprintf("notifications.dedupeWindow: %q is not a duration (e.g. 10m, 1h)", c.DedupeWindow))
+		}
+	}
+	if c.RateLimit < 0 {
+		problems = append(problems, fmt.Sprintf("notifications.rateLimit: must be positive, got %d", c.RateLimit))
+	}
+	return problems
+}
+
+// notificationState tracks watched resources between polls.
+// It is shared by model copies and only changed in Update.
+type notificationState struct {
+	scope    string               // Context and namespace the baseline belongs to
+	baseline map[string]bool      // Triggers polled once in the scope; the first poll never notifies
+	states   map[string]string    // Problem state per resource, e.g. "Pod/ns/name" → "CrashLoopBackOff"
+	events   map[string]int32     // Warning event UID → count
+	lastSent map[string]time.Time // Dedupe key → last notification within the dedupe window
+	sent     []time.Time          // Send times within the last minute
+	queued   []*Notification      // Rate-limited notifications, sent oldest first once the limit allows
+	history  []*Notification      // Oldest first
+	lastErr  error                // Errors of the last poll
+	nextID   int
+}
+
+// newNotificationState creates an empty notification state
+func newNotificationState() *notificationState {
+	return &notificationState{
+		baseline: map[string]bool{},
+		states:   map[string]string{},
+		events:   map[string]int32{},
+		lastSent: map[string]time.Time{},
+	}
+}
+
+// prune forgets the sends older than the rate-limit minute and the dedupe keys older than window
+func (s *notificationState) prune(now time.Time, window time.Duration) {
+	recent := s.sent[:0]
+	for _, t := range s.sent {
+		if now.Sub(t) < time.Minute {
+			recent = append(recent, t)
+		}
+	}
+	s.sent = recent
+	for key, last := range s.lastSent {
+		if now.Sub(last) >= window {
+			delete(s.lastSent, key)
+		}
+	}
+}
+
+type notificationTickMsg struct{}
+
+// watchObservation is the problem state of one watched resource
+type watchObservation struct {
+	Kind      string
+	Namespace string
+	Name      string
+	State     string // CrashLoopBackOff, NotReady, or empty when healthy
+	Message   string
+}
+
+// watchTrigger returns the trigger watching the resource of an observation key
+func watchTrigger(key string) string {
+	if strings.HasPrefix(key, "Node/") {
+		return triggerNotReady
+	}
+	return triggerCrashLoop
+}
+
+type notificationPollMsg struct {
+	context      string
+	scope        string // Context and namespace the poll watched
+	observations map[string]watchObservation
+	events       []corev1.Event
+	errs         map[string]error // Trigger → why its list failed; the other triggers are still polled
+}
+
+type notificationSentMsg struct {
+	id  int
+	err error
+}
+
+// scheduleNotificationPoll arms the next poll of the watched resources, if notifications are configured
+func (m Model) scheduleNotificationPoll() tea.Cmd {
+	if m.notifications == nil || m.config == nil || !m.config.Notifications.enabled() || m.replay != nil {
+		return nil
+	}
+	return tea.Tick(m.config.Notifications.interval(), func(t time.Time) tea.Msg {
+		return notificationTickMsg{}
+	})
+}
+
+// pollWatched lists pods and Warning events in the selected namespace (all until one is chosen) and the nodes
+func (m Model) pollWatched() tea.Cmd {
+	return func() tea.Msg {
+		cfg := m.config.Notifications
+		namespace := m.selectedNamespace
+		if m.selectedScope == ClusterScoped {
+			namespace = metav1.NamespaceAll
+		}
+		msg := notificationPollMsg{context: m.selectedKubeContext, scope: m.selectedKubeContext + "/" + namespace,
+			observations: map[string]watchObservation{}, errs: map[string]error{}}
+		
+		if cfg.triggered(triggerCrashLoop) {
+			if pods, err := m.clientset.CoreV1().Pods(namespace).List(m.ctx, metav1.ListOptions{}); err != nil {
+				msg.errs[triggerCrashLoop] = fmt.Errorf("failed to list pods: %v", err)
+			} else {
+				for _, pod := range pods.Items {
+					obs := watchObservation{Kind: "Pod", Namespace: pod.Namespace, Name: pod.Name}
+					for _, cs := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+						if cs.State.Waiting != nil && cs.State.Waiting.Reason == "CrashLoopBackOff" {
+							obs.State = "CrashLoopBackOff"
+							obs.Message = fmt.Sprintf("container %s restarted %d times", cs.Name, cs.RestartCount)
+							break
+						}
+					}
+					msg.observations["Pod/"+pod.Namespace+"/"+pod.Name] = obs
+				}
+			}
+		}
+		if cfg.triggered(triggerNotReady) {
+			// Namespace-scoped users usually cannot list nodes; pods and events are still watched
+			if nodes, err := m.clientset.CoreV1().Nodes().List(m.ctx, metav1.ListOptions{}); err != nil {
+				msg.errs[triggerNotReady] = fmt.Errorf("failed to list nodes: %v", err)
+			} else {
+				for _, node := range nodes.Items {
+					obs := watchObservation{Kind: "Node", Name: node.Name}
+					for _, condition := range node.Status.Conditions {
+						if condition.Type == corev1.NodeReady && condition.Status != corev1.ConditionTrue {
+							obs.State = "NotReady"
+							obs.Message = condition.Message
+						}
+					}
+					msg.observations["Node/"+node.Name] = obs
+				}
+			}
+		}
+		if cfg.triggered(triggerWarningEvent) {
+			if events, err := m.clientset.CoreV1().Events(namespace).List(m.ctx, metav1.ListOptions{FieldSelector: "type=Warning"}); err != nil {
+				msg.errs[triggerWarningEvent] = fmt.Errorf("failed to list events: %v", err)
+			} else {
+				msg.events = events.Items
+			}
+		}
+		return msg
+	}
+}
+
+// processNotificationPoll compares a poll with the previous one and notifies about the transitions
+func (m Model) processNotificationPoll(msg notificationPollMsg) tea.Cmd {
+	state := m.notifications
+	cfg := m.config.Notifications
+	var failures []string
+	for _, trigger := range notificationTriggers {
+		if err := msg.errs[trigger]; err != nil {
+			failures = append(failures, err.Error())
+		}
+	}
+	state.lastErr = nil
+	if len(failures) > 0 {
+		state.lastErr = errors.New(strings.Join(failures, "; "))
+	}
+	if state.scope != msg.scope {
+		// Another context or namespace: start a new baseline
+		state.scope = msg.scope
+		state.baseline = map[string]bool{}
+		state.states, state.events = map[string]string{}, map[string]int32{}
+	}
+	
+	var pending []Notification
+	keys := make([]string, 0, len(msg.observations))
+	for key := range msg.observations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		obs := msg.observations[key]
+		if obs.State == "" || !state.baseline[watchTrigger(key)] || state.states[key] == obs.State {
+			continue
+		}
+		n := Notification{Trigger: watchTrigger(key), Kind: obs.Kind, Namespace: obs.Namespace, Name: obs.Name, Reason: obs.State, Message: obs.Message}
+		if n.Trigger == triggerNotReady {
+			n.Text = fmt.Sprintf("🔴 [%s] Node %s is NotReady", msg.context, obs.Name)
+		} else {
+			n.Text = fmt.Sprintf("🔴 [%s] %s %s/%s entered CrashLoopBackOff", msg.context, obs.Kind, obs.Namespace, obs.Name)
+		}
+		if obs.Message != "" {
+			n.Text += ": " + obs.Message
+		}
+		pending = append(pending, n)
+	}
+	states := make(map[string]string, len(msg.observations))
+	for key, obs := range msg.observations {
+		states[key] = obs.State
+	}
+	for key, previous := range state.states {
+		if msg.errs[watchTrigger(key)] != nil {
+			states[key] = previous // Not listed this time
+		}
+	}
+	
+	events := state.events
+	if msg.errs[triggerWarningEvent] == nil {
+		events = make(map[string]int32, len(msg.events))
+		for _, event := range msg.events {
+			uid := string(event.UID)
+			count := max(event.Count, 1)
+			if seen, ok := state.events[uid]; state.baseline[triggerWarningEvent] && (!ok || count > seen) {
+				obj := event.InvolvedObject
+				where := obj.Name
+				if obj.Namespace != "" {
+					where = obj.Namespace + "/" + obj.Name
+				}
+				pending = append(pending, Notification{Trigger: triggerWarningEvent, Kind: obj.Kind, Namespace: obj.Namespace,
+					Name: obj.Name, Reason: event.Reason, Message: event.Message,
+					Text: fmt.Sprintf("⚠️ [%s] %s on %s %s: %s", msg.context, event.Reason, obj.Kind, where, event.Message)})
+			}
+			events[uid] = count
+		}
+	}
+	state.states, state.events = states, events
+	for _, trigger := range notificationTriggers {
+		if cfg.triggered(trigger) && msg.errs[trigger] == nil {
+			state.baseline[trigger] = true
+		}
+	}
+	
+	cmds := m.sendQueued()
+	for _, n := range pending {
+		n.Context = msg.context
+		cmds = append(cmds, m.notify(n))
+	}
+	return tea.Batch(cmds...)
+}
+
+// notify records a notification and sends it unless it is a duplicate or over the rate limit
+func (m Model) notify(n Notification) tea.Cmd {
+	state := m.notifications
+	cfg := m.config.Notifications
+	now := time.Now()
+	n.Time = now
+	
+	state.prune(now, cfg.dedupeWindow())
+	key := n.dedupeKey()
+	if _, ok := state.lastSent[key]; ok {
+		for i := len(state.history) - 1; i >= 0; i-- {
+			if state.history[i].dedupeKey() == key {
+				state.history[i].Repeats++
+				break
+			}
+		}
+		return nil
+	}
+	
+	state.nextID++
+	n.ID = state.nextID
+	record := &n
+	state.history = append(state.history, record)
+	if len(state.history) > notificationHistoryLimit {
+		state.history = state.history[len(state.history)-notificationHistoryLimit:]
+	}
+	state.lastSent[key] = now
+	if len(state.sent) >= cfg.rateLimit() || len(state.queued) > 0 {
+		// Sent by a later poll, after the notifications already waiting
+		record.Status = "rate-limited"
+		state.queued = append(state.queued, record)
+		if len(state.queued) > notificationHistoryLimit {
+			state.queued[0].Status = "dropped"
+			state.queued = state.queued[1:]
+		}
+		return nil
+	}
+	state.sent = append(state.sent, now)
+	record.Status = "sending"
+	return sendNotification(cfg, n)
+}
+
+// sendQueued sends as many rate-limited notifications as the limit allows now, oldest first
+func (m Model) sendQueued() []tea.Cmd {
+	state := m.notifications
+	cfg := m.config.Notifications
+	now := time.Now()
+	state.prune(now, cfg.dedupeWindow())
+	
+	var cmds []tea.Cmd
+	for len(state.queued) > 0 && len(state.sent) < cfg.rateLimit() {
+		record := state.queued[0]
+		state.queued = state.queued[1:]
+		state.sent = append(state.sent, now)
+		record.Status = "sending"
+		cmds = append(cmds, sendNotification(cfg, *record))
+	}
+	return cmds
+}
+
+// sendNotification posts a notification to the webhook
+func sendNotification(cfg NotificationConfig, n Notification) tea.Cmd {
+	return func() tea.Msg {
+		body, err := cfg.body(n)
+		if err != nil {
+			return notificationSentMsg{id: n.ID, err: err}
+		}
+		req, err := http.NewRequest(http.MethodPost, cfg.Webhook, bytes.NewReader(body))
+		if err != nil {
+			return notificationSentMsg{id: n.ID, err: err}
+		}
+		req.Header.Set("Content-Type", "application/json")
+		for name, value := range cfg.Headers {
+			req.Header.Set(name, value)
+		}
+		resp, err := (&http.Client{Timeout: 10 * time.Second}).Do(req)
+		if err != nil {
+			return notificationSentMsg{id: n.ID, err: err}
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= 300 {
+			reply, _ := io.ReadAll(io.LimitReader(resp.Body, 200))
+			return notificationSentMsg{id: n.ID, err: fmt.Errorf("webhook returned %s: %s", resp.Status, strings.TrimSpace(string(reply)))}
+		}
+		return notificationSentMsg{id: n.ID}
+	}
+}
+
+// openNotificationsView shows the notification history on top of the current view
+func (m Model) openNotificationsView() (tea.Model, tea.Cmd) {
+	m.viewStack = append(m.viewStack, m.currentView)
+	m.currentView = NotificationsView
+	m.cursor = 0
+	return m, nil
+}
+
+// renderNotifications shows the notification history, newest first
+func (m Model) renderNotifications() string {
+	var content strings.Builder
+	headerStyle := lipgloss.NewStyle().Foreground(colors.Success).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(colors.Text)
+	selectedStyle := colors.selection(colors.Secondary)
+	errorStyle := lipgloss.NewStyle().Foreground(colors.Error)
+	mutedStyle := lipgloss.NewStyle().Foreground(colors.Muted)
+	
+	var history []*Notification
+	if m.notifications != nil {
+		history = m.notifications.history
+	}
+	content.WriteString(headerStyle.Render(fmt.Sprintf("🔔 Notifications (%d)", len(history))) + "\n")
+	
+	cfg := NotificationConfig{}
+	if m.config != nil {
+		cfg = m.config.Notifications
+	}
+	if !cfg.enabled() {
+		content.WriteString("\n" + mutedStyle.Render("Notifications are off. Set notifications.webhook in "+configFilePath()+" to be notified about")+"\n")
+		content.WriteString(mutedStyle.Render("pods entering CrashLoopBackOff, nodes going NotReady and new Warning events.") + "\n")
+		return content.String()
+	}
+	triggers := cfg.Triggers
+	if len(triggers) == 0 {
+		triggers = notificationTriggers
+	}
+	watched := "all namespaces"
+	if m.selectedNamespace != metav1.NamespaceAll && m.selectedScope != ClusterScoped {
+		watched = "namespace " + m.selectedNamespace
+	}
+	content.WriteString(mutedStyle.Render(fmt.Sprintf("Watching %s in %s every %s · dedupe %s · at most %d/min",
+		strings.Join(triggers, ", "), watched, cfg.interval(), cfg.dedupeWindow(), cfg.rateLimit())) + "\n")
+	if m.notifications.lastErr != nil {
+		content.WriteString(errorStyle.Render("❌ Last poll failed: "+m.notifications.lastErr.Error()) + "\n")
+	}
+	content.WriteString("\n")
+	if len(history) == 0 {
+		content.WriteString(normalStyle.Render("No notifications yet") + "\n")
+		return content.String()
+	}
+	
+	// Keep the cursor visible
+	visible := max(m.height-16, 5)
+	start := 0
+	if m.cursor >= visible {
+		start = m.cursor - visible + 1
+	}
+	for row := start; row < len(history) && row < start+visible; row++ {
+		n := history[len(history)-1-row]
+		icon := map[string]string{"sending": "⏳", "sent": "✅", "failed": "❌", "rate-limited": "🚦", "dropped": "🚫"}[n.Status]
+		line := fmt.Sprintf("%s %s %-12s %s", n.Time.Format("15:04:05"), icon, n.Status, n.Text)
+		if n.Repeats > 0 {
+			line += fmt.Sprintf(" (×%d)", n.Repeats+1)
+		}
+		line = truncateString(line, max(m.width-2, 40))
+		if row == m.cursor {
+			content.WriteString(selectedStyle.Render(line) + "\n")
+		} else if n.Status == "failed" {
+			content.WriteString(errorStyle.Render(line) + "\n")
+		} else {
+			content.WriteString(normalStyle.Render(line) + "\n")
+		}
+		if row == m.cursor && n.Error != "" {
+			content.WriteString(mutedStyle.Render("     ↳ "+n.Error) + "\n")
+		}
+	}
+	return content.String()
+}
+
+// snapshotVersion is the format version of snapshot files; version 2 recordings append frames after the snapshot
+const snapshotVersion = 2
+
+// minRecordInterval keeps timed recordings from listing the whole namespace too often
+const minRecordInterval = 10 * time.Second
+
+// Snapshot is recorded cluster state, written gzip-compressed by :snapshot and :record and opened with --replay
+type Snapshot struct {
+	Version   int             `json:"version"`
+	Context   string          `json:"context"`
+	Namespace string          `json:"namespace,omitempty"` // Namespace the namespaced kinds were recorded in, empty for all
+	Frames    []SnapshotFrame `json:"frames"`              // Recorded points in time, oldest first
+}
+
+// SnapshotFrame is the cluster state at one point in time
+type SnapshotFrame struct {
+	Time    time.Time         `json:"time"`
+	Objects []json.RawMessage `json:"objects"`           // API objects with apiVersion and kind
+	Logs    map[string]string `json:"logs,omitempty"`    // Pod logs loaded since the previous frame, by namespace/name
+	Skipped []string          `json:"skipped,omitempty"` // Kinds that could not be listed, with the reason
+}
+
+// snapshotKind is an API collection recorded in snapshots: everything the loaders read
+type snapshotKind struct {
+	Resource   string
+	Namespaced bool
+	List       func(m Model, namespace string) (runtime.Object, error)
+}
+
+// snapshotKinds are listed for every frame, in this order
+var snapshotKinds = []snapshotKind{
+	{"namespaces", false, func(m Model, _ string) (runtime.Object, error) {
+		return m.clientset.CoreV1().Namespaces().List(m.ctx, metav1.ListOptions{})
+	}},
+	{"nodes", false, func(m Model, _ string) (runtime.Object, error) {
+		return m.clientset.CoreV1().Nodes().List(m.ctx, metav1.ListOptions{})
+	}},
+	{"pods", true, func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.CoreV1().Pods(namespace).List(m.ctx, metav1.ListOptions{})
+	}},
+	{"services", true, func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.CoreV1().Services(namespace).List(m.ctx, metav1.ListOptions{})
+	}},
+	{"endpointslices", true, func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.DiscoveryV1().EndpointSlices(namespace).List(m.ctx, metav1.ListOptions{})
+	}},
+	{"persistentvolumeclaims", true, func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.CoreV1().PersistentVolumeClaims(namespace).List(m.ctx, metav1.ListOptions{})
+	}},
+	{"deployments", true, func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.AppsV1().Deployments(namespace).List(m.ctx, metav1.ListOptions{})
+	}},
+	{"replicasets", true, func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.AppsV1().ReplicaSets(namespace).List(m.ctx, metav1.ListOptions{})
+	}},
+	{"daemonsets", true, func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.AppsV1().DaemonSets(namespace).List(m.ctx, metav1.ListOptions{})
+	}},
+	{"statefulsets", true, func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.AppsV1().StatefulSets(namespace).List(m.ctx, metav1.ListOptions{})
+	}},
+	{"jobs", true, func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.BatchV1().Jobs(namespace).List(m.ctx, metav1.ListOptions{})
+	}},
+	{"cronjobs", true, func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.BatchV1().CronJobs(namespace).List(m.ctx, metav1.ListOptions{})
+	}},
+	{"ingresses", true, func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.NetworkingV1().Ingresses(namespace).List(m.ctx, metav1.ListOptions{})
+	}},
+	{"events", true, func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.CoreV1().Events(namespace).List(m.ctx, metav1.ListOptions{})
+	}},
+}
+
+// captureFrame lists every snapshotKind; namespaced kinds come from namespace, or all namespaces when it is empty
+func (m Model) captureFrame(namespace string, logs map[string]string) SnapshotFrame {
+	frame := SnapshotFrame{Time: time.Now().UTC(), Objects: []json.RawMessage{}, Logs: logs}
+	for _, kind := range snapshotKinds {
+		list, err := kind.List(m, namespace)
+		if err != nil {
+			frame.Skipped = append(frame.Skipped, fmt.Sprintf("%s: %v", kind.Resource, err))
+			continue
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			frame.Skipped = append(frame.Skipped, fmt.Sprintf("%s: %v", kind.Resource, err))
+			continue
+		}
+		for _, item := range items {
+			data, err := encodeSnapshotObject(item)
+			if err != nil {
+				frame.Skipped = append(frame.Skipped, fmt.Sprintf("%s: %v", kind.Resource, err))
+				break
+			}
+			frame.Objects = append(frame.Objects, data)
+		}
+	}
+	return frame
+}
+
+// encodeSnapshotObject serializes an API object with its apiVersion and kind, without managed fields
+func encodeSnapshotObject(obj runtime.Object) (json.RawMessage, error) {
+	obj = obj.DeepCopyObject()
+	gvks, _, err := scheme.Scheme.ObjectKinds(obj)
+	if err != nil {
+		return nil, err
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvks[0])
+	if accessor, err := meta.Accessor(obj); err == nil {
+		accessor.SetManagedFields(nil)
+	}
+	return json.Marshal(obj)
+}
+
+// writeSnapshot writes a gzip-compressed snapshot through a temporary file, so an existing file is never left half-written
+func writeSnapshot(path string, snapshot *Snapshot) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".k8sgo-snapshot-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	gz := gzip.NewWriter(tmp)
+	if err := json.NewEncoder(gz).Encode(snapshot); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// appendSnapshotFrame adds a frame to a snapshot file as another gzip member, so a recording is not rewritten
+// per frame; a failed write is cut off again to keep the earlier frames readable
+func appendSnapshotFrame(path string, frame SnapshotFrame) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	gz := gzip.NewWriter(file)
+	err = json.NewEncoder(gz).Encode(frame)
+	if err == nil {
+		err = gz.Close()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Truncate(path, info.Size())
+	}
+	return err
+}
+
+// readSnapshot reads a snapshot written by writeSnapshot, with the frames appendSnapshotFrame added
+func readSnapshot(path string) (*Snapshot, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("not a k8sgo snapshot: %v", err)
+	}
+	var snapshot Snapshot
+	decoder := json.NewDecoder(gz)
+	if err := decoder.Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("not a k8sgo snapshot: %v", err)
+	}
+	if snapshot.Version < 1 || snapshot.Version > snapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d (this k8sgo reads versions 1 to %d)", snapshot.Version, snapshotVersion)
+	}
+	for {
+		var frame SnapshotFrame
+		if err := decoder.Decode(&frame); err != nil {
+			break // The end, or a frame cut off when k8sgo exited while writing it
+		}
+		snapshot.Frames = append(snapshot.Frames, frame)
+	}
+	if len(snapshot.Frames) == 0 {
+		return nil, fmt.Errorf("the snapshot has no recorded frames")
+	}
+	return &snapshot, nil
+}
+
+// defaultSnapshotPath names a snapshot of a context in the working directory
+func defaultSnapshotPath(contextName string) string {
+	name := strings.NewReplacer("/", "_", ":", "_", " ", "_").Replace(contextName)
+	if name == "" {
+		name = "cluster"
+	}
+	return fmt.Sprintf("k8sgo-%s-%s.snap.gz", name, time.Now().Format("20060102-150405"))
+}
+
+// recordedLog is the text of a pod's logs as the API returned it
+type recordedLog struct {
+	Text     string
+	LoadedAt time.Time
+}
+
+// snapshotRecorder holds the timed recording in progress and the logs loaded this session, shared across model copies
+type snapshotRecorder struct {
+	snapshot  *Snapshot // Context and namespace of the recording; the frames are only kept in the file
+	frames    int       // Frames written
+	path      string
+	interval  time.Duration
+	run       int  // Incremented per recording so that ticks of a stopped one are ignored
+	active    bool
+	lastFrame time.Time
+	lastErr   error
+	logs      map[string]recordedLog // By namespace/name
+}
+
+// newSnapshotRecorder returns an idle recorder
+func newSnapshotRecorder() *snapshotRecorder {
+	return &snapshotRecorder{logs: map[string]recordedLog{}}
+}
+
+// logsSince returns the logs loaded after t, for the next frame
+func (r *snapshotRecorder) logsSince(t time.Time) map[string]string {
+	logs := map[string]string{}
+	for key, log := range r.logs {
+		if log.LoadedAt.After(t) {
+			logs[key] = log.Text
+		}
+	}
+	return logs
+}
+
+// snapshotCapturedMsg reports a frame written by recordSnapshot
+type snapshotCapturedMsg struct {
+	run   int // 0 for a one-off :snapshot
+	path  string
+	frame SnapshotFrame
+	err   error
+}
+
+// snapshotTickMsg asks for the next frame of a timed recording
+type snapshotTickMsg struct {
+	run int
+}
+
+// recordNamespace is the namespace a snapshot records: the selected one, or all namespaces outside a single namespace
+func (m Model) recordNamespace() string {
+	if m.selectedScope == NamespaceScoped {
+		return m.selectedNamespace
+	}
+	return metav1.NamespaceAll
+}
+
+// recordSnapshot captures a frame and writes it to path: as a new snapshot of base, or appended to the file
+func (m Model) recordSnapshot(path string, run int, base Snapshot, logs map[string]string, appendFrame bool) tea.Cmd {
+	return func() tea.Msg {
+		frame := m.captureFrame(base.Namespace, logs)
+		var err error
+		if appendFrame {
+			err = appendSnapshotFrame(path, frame)
+		} else {
+			base.Frames = []SnapshotFrame{frame}
+			err = writeSnapshot(path, &base)
+		}
+		return snapshotCapturedMsg{run: run, path: path, frame: frame, err: err}
+	}
+}
+
+// takeSnapshot writes the current state and every log loaded this session to path (a generated name when empty)
+func (m Model) takeSnapshot(path string) (tea.Model, tea.Cmd) {
+	if path == "" {
+		path = defaultSnapshotPath(m.selectedKubeContext)
+	}
+	base := Snapshot{Version: snapshotVersion, Context: m.selectedKubeContext, Namespace: m.recordNamespace()}
+	m.statusMessage = ""
+	m.noticeMessage = fmt.Sprintf("Writing snapshot to %s...", path)
+	return m, m.recordSnapshot(path, 0, base, m.recorder.logsSince(time.Time{}), false)
+}
+
+// startRecording records a frame now and then every interval until ':record stop'
+func (m Model) startRecording(interval time.Duration) (tea.Model, tea.Cmd) {
+	r := m.recorder
+	if r.active {
+		m.errorMessage = fmt.Sprintf("Already recording to %s (':record stop' first)", r.path)
+		return m, nil
+	}
+	r.run++
+	r.active = true
+	r.interval = interval
+	r.path = defaultSnapshotPath(m.selectedKubeContext)
+	r.snapshot = &Snapshot{Version: snapshotVersion, Context: m.selectedKubeContext, Namespace: m.recordNamespace()}
+	r.frames = 0
+	r.lastFrame = time.Time{}
+	r.lastErr = nil
+	m.statusMessage = fmt.Sprintf("Recording every %s to %s", interval, r.path)
+	return m, m.nextRecordedFrame()
+}
+
+// nextRecordedFrame captures the next frame of the active recording with the logs loaded since the last one
+func (m Model) nextRecordedFrame() tea.Cmd {
+	r := m.recorder
+	logs := r.logsSince(r.lastFrame)
+	r.lastFrame = time.Now()
+	return m.recordSnapshot(r.path, r.run, *r.snapshot, logs, r.frames > 0)
+}
+
+// stopRecording ends the active recording; the file already holds every frame
+func (m Model) stopRecording() (tea.Model, tea.Cmd) {
+	r := m.recorder
+	if !r.active {
+		m.errorMessage = "No recording in progress"
+		return m, nil
+	}
+	r.active = false
+	m.statusMessage = fmt.Sprintf("Recording stopped: %d frame(s) in %s", r.frames, r.path)
+	return m, nil
+}
+
+// handleSnapshotCaptured records a written frame and arms the next one of a timed recording
+func (m Model) handleSnapshotCaptured(msg snapshotCapturedMsg) (tea.Model, tea.Cmd) {
+	m.noticeMessage = ""
+	if msg.run == 0 {
+		if msg.err != nil {
+			m.errorMessage = fmt.Sprintf("Cannot write snapshot: %v", msg.err)
+			return m, nil
+		}
+		m.statusMessage = fmt.Sprintf("Snapshot of %d objects and %d pod log(s) written to %s (replay with: k8sgo --replay %s)",
+			len(msg.frame.Objects), len(msg.frame.Logs), msg.path, msg.path)
+		if len(msg.frame.Skipped) > 0 {
+			m.noticeMessage = "Not recorded: " + strings.Join(msg.frame.Skipped, "; ")
+		}
+		return m, nil
+	}
+
+	r := m.recorder
+	if msg.run != r.run || !r.active {
+		return m, nil
+	}
+	if msg.err != nil {
+		// Keep recording; the file still holds the frames written before
+		r.lastErr = msg.err
+		m.errorMessage = fmt.Sprintf("Cannot write recording: %v", msg.err)
+	} else {
+		r.lastErr = nil
+		r.frames++
+	}
+	run := r.run
+	return m, tea.Tick(r.interval, func(t time.Time) tea.Msg {
+		return snapshotTickMsg{run: run}
+	})
+}
+
+// recordingStatus is the header note of an active recording, empty when idle
+func (m Model) recordingStatus() string {
+	r := m.recorder
+	if r == nil || !r.active {
+		return ""
+	}
+	status := fmt.Sprintf("⏺ REC %d frame(s)", r.frames)
+	if r.lastErr != nil {
+		status += " (write failed)"
+	}
+	return status
+}
+
+// replayState is a snapshot opened with --replay, or a dump opened with --dump; the clientset of each frame is
+// built on first use
+type replayState struct {
+	path       string
+	snapshot   *Snapshot
+	clientsets map[int]kubernetes.Interface
+	skipped    map[int]int // Objects of kinds this client has no types for, per frame
+	dump       bool        // Loaded from a must-gather or cluster-info dump
+	notice     string      // What was loaded, shown on startup
+}
+
+// newReplayState opens a snapshot for replay
+func newReplayState(path string, snapshot *Snapshot) *replayState {
+	return &replayState{path: path, snapshot: snapshot, clientsets: map[int]kubernetes.Interface{}, skipped: map[int]int{}}
+}
+
+// clientset returns a fake clientset serving the objects of a frame
+func (r *replayState) clientset(frame int) (kubernetes.Interface, error) {
+	if cs, ok := r.clientsets[frame]; ok {
+		return cs, nil
+	}
+	if frame < 0 || frame >= len(r.snapshot.Frames) {
+		return nil, fmt.Errorf("frame %d out of range", frame+1)
+	}
+
+	decoder := scheme.Codecs.UniversalDeserializer()
+	var objects []runtime.Object
+	for _, raw := range r.snapshot.Frames[frame].Objects {
+		obj, _, err := decoder.Decode(raw, nil, nil)
+		if err != nil {
+			r.skipped[frame]++
+			continue
+		}
+		objects = append(objects, obj)
+	}
+	cs, err := offlineClientset(objects)
+	if err != nil {
+		return nil, err
+	}
+	r.clientsets[frame] = cs
+	return cs, nil
+}
+
+// podLogs returns a pod's logs as last loaded at or before frame
+func (r *replayState) podLogs(frame int, namespace, name string) (string, bool) {
+	key := namespace + "/" + name
+	for i := frame; i >= 0; i-- {
+		if text, ok := r.snapshot.Frames[i].Logs[key]; ok {
+			return text, true
+		}
+	}
+	return "", false
+}
+
+// offlineClientset serves objects from memory the way the API server would for the loaders: read-only, with
+// every read allowed and field selectors applied
+func offlineClientset(objects []runtime.Object) (kubernetes.Interface, error) {
+	// Objects may come from namespaces whose Namespace object was not recorded
+	recorded := map[string]bool{}
+	referenced := map[string]bool{}
+	for _, obj := range objects {
+		if ns, ok := obj.(*corev1.Namespace); ok {
+			recorded[ns.Name] = true
+		} else if accessor, err := meta.Accessor(obj); err == nil && accessor.GetNamespace() != "" {
+			referenced[accessor.GetNamespace()] = true
+		}
+	}
+	for name := range referenced {
+		if !recorded[name] {
+			objects = append(objects, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}})
+		}
+	}
+
+	cs := fake.NewSimpleClientset()
+	for _, obj := range objects {
+		if err := cs.Tracker().Add(obj); err != nil && !apierrors.IsAlreadyExists(err) {
+			return nil, err
+		}
+	}
+	tracker := cs.Tracker()
+	cs.PrependReactor("*", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		switch action.GetVerb() {
+		case "get", "watch":
+			return false, nil, nil
+		case "list":
+			return offlineList(tracker, action)
+		case "create":
+			if review, ok := offlineAccessReview(action); ok {
+				return true, review, nil
+			}
+		}
+		return true, nil, apierrors.NewMethodNotSupported(action.GetResource().GroupResource(), action.GetVerb())
+	})
+	return cs, nil
+}
+
+// offlineAccessReview answers permission checks: reads are allowed, changes are not
+func offlineAccessReview(action k8stesting.Action) (runtime.Object, bool) {
+	create, ok := action.(k8stesting.CreateAction)
+	if !ok {
+		return nil, false
+	}
+	readVerbs := []string{"get", "list", "watch"}
+	switch review := create.GetObject().(type) {
+	case *authorizationv1.SelfSubjectRulesReview:
+		review = review.DeepCopy()
+		review.Status = authorizationv1.SubjectRulesReviewStatus{
+			ResourceRules: []authorizationv1.ResourceRule{{Verbs: readVerbs, APIGroups: []string{"*"}, Resources: []string{"*"}}},
+		}
+		return review, true
+	case *authorizationv1.SelfSubjectAccessReview:
+		review = review.DeepCopy()
+		attrs := review.Spec.ResourceAttributes
+		review.Status.Allowed = attrs == nil || containsString(readVerbs, attrs.Verb)
+		if !review.Status.Allowed {
+			review.Status.Reason = "offline cluster state is read-only"
+		}
+		return review, true
+	}
+	return nil, false
+}
+
+// offlineList applies the field selector of a list, which the fake clientset ignores; label selectors it applies itself
+func offlineList(tracker k8stesting.ObjectTracker, action k8stesting.Action) (bool, runtime.Object, error) {
+	list, ok := action.(k8stesting.ListAction)
+	if !ok {
+		return false, nil, nil
+	}
+	selector := list.GetListRestrictions().Fields
+	if selector == nil || selector.Empty() {
+		return false, nil, nil
+	}
+	_, result, err := k8stesting.ObjectReaction(tracker)(action)
+	if err != nil {
+		return true, nil, err
+	}
+	items, err := meta.ExtractList(result)
+	if err != nil {
+		return true, nil, err
+	}
+	var kept []runtime.Object
+	for _, item := range items {
+		if selector.Matches(objectFields(item)) {
+			kept = append(kept, item)
+		}
+	}
+	if err := meta.SetList(result, kept); err != nil {
+		return true, nil, err
+	}
+	return true, result, nil
+}
+
+// objectFields returns the fields the API server supports in field selectors for an object
+func objectFields(obj runtime.Object) fields.Set {
+	set := fields.Set{}
+	if accessor, err := meta.Accessor(obj); err == nil {
+		set["metadata.name"] = accessor.GetName()
+		set["metadata.namespace"] = accessor.GetNamespace()
+	}
+	switch o := obj.(type) {
+	case *corev1.Pod:
+		set["spec.nodeName"] = o.Spec.NodeName
+		set["spec.restartPolicy"] = string(o.Spec.RestartPolicy)
+		set["spec.schedulerName"] = o.Spec.SchedulerName
+		set["spec.serviceAccountName"] = o.Spec.ServiceAccountName
+		set["spec.hostNetwork"] = strconv.FormatBool(o.Spec.HostNetwork)
+		set["status.phase"] = string(o.Status.Phase)
+		set["status.podIP"] = o.Status.PodIP
+		set["status.nominatedNodeName"] = o.Status.NominatedNodeName
+	case *corev1.Event:
+		set["involvedObject.kind"] = o.InvolvedObject.Kind
+		set["involvedObject.namespace"] = o.InvolvedObject.Namespace
+		set["involvedObject.name"] = o.InvolvedObject.Name
+		set["involvedObject.uid"] = string(o.InvolvedObject.UID)
+		set["involvedObject.apiVersion"] = o.InvolvedObject.APIVersion
+		set["involvedObject.resourceVersion"] = o.InvolvedObject.ResourceVersion
+		set["involvedObject.fieldPath"] = o.InvolvedObject.FieldPath
+		set["reason"] = o.Reason
+		set["reportingComponent"] = o.ReportingController
+		set["source"] = o.Source.Component
+		set["type"] = o.Type
+	case *corev1.Node:
+		set["spec.unschedulable"] = strconv.FormatBool(o.Spec.Unschedulable)
+	case *corev1.Namespace:
+		set["status.phase"] = string(o.Status.Phase)
+	}
+	return set
+}
+
+// now is the time ages and time-based health checks are measured against: the recording time when replaying
+func (m Model) now() time.Time {
+	if m.replay != nil {
+		return m.replay.snapshot.Frames[m.replayFrame].Time
+	}
+	return time.Now()
+}
+
+// seekReplay shows another recorded point in time and reloads the current view from it
+func (m Model) seekReplay(frame int) (tea.Model, tea.Cmd) {
+	if m.replay == nil {
+		m.errorMessage = "Not replaying a snapshot (start k8sgo with --replay FILE)"
+		return m, nil
+	}
+	if len(m.replay.snapshot.Frames) == 1 {
+		m.errorMessage = "Only one point in time was recorded"
+		return m, nil
+	}
+	if frame < 0 || frame >= len(m.replay.snapshot.Frames) {
+		return m, nil
+	}
+	clientset, err := m.replay.clientset(frame)
+	if err != nil {
+		m.errorMessage = fmt.Sprintf("Cannot replay frame %d: %v", frame+1, err)
+		return m, nil
+	}
+	m.clientset = clientset
+	m.replayFrame = frame
+	m.errorMessage = ""
+	cmd := m.reloadView()
+	m.loading = cmd != nil
+	return m, cmd
+}
+
+// reloadView reloads the data shown by the current view
+func (m Model) reloadView() tea.Cmd {
+	switch m.currentView {
+	case NamespaceView:
+		return m.loadNamespaces()
+	case DetailView, MultiFrameView:
+		return m.loadResources()
+	case LogView:
+		return m.loadLogs()
+	case EventView:
+		return m.loadEventsCmd()
+	case CronJobHistoryView:
+		return m.loadCronJobHistory()
+	case OwnershipTreeView:
+		if m.ownershipTarget != nil {
+			return m.loadOwnershipTree(*m.ownershipTarget)
+		}
+	case TrafficPathView:
+		if m.trafficTarget != nil {
+			return m.loadTrafficPath(*m.trafficTarget)
+		}
+	case DashboardView:
+		return m.scanClusterHealth()
+	case CompareView, CompareDiffView:
+		if m.comparison != nil {
+			return m.loadComparison(m.comparison.Left, m.comparison.Right, m.comparison.Kinds)
+		}
+	}
+	return nil
+}
+
+// replayStatus is the header note while replaying: the recorded point in time shown
+func (m Model) replayStatus() string {
+	if m.replay == nil {
+		return ""
+	}
+	frame := m.replay.snapshot.Frames[m.replayFrame]
+	if m.replay.dump {
+		return fmt.Sprintf("📦 Dump collected %s", frame.Time.Local().Format("2006-01-02 15:04:05"))
+	}
+	return fmt.Sprintf("⏮ Replay %d/%d %s", m.replayFrame+1, len(m.replay.snapshot.Frames),
+		frame.Time.Local().Format("2006-01-02 15:04:05"))
+}
+
+// dumpLogLimit is how much of each pod log file a dump keeps, from the end
+const dumpLogLimit = 1 << 20
+
+// loadDump reads an OpenShift must-gather or 'kubectl cluster-info dump' directory, or a tar archive of one
+// (optionally gzip-compressed), as cluster state browsed like a single-frame snapshot
+func loadDump(path string) (*replayState, error) {
+	decoder := scheme.Codecs.UniversalDeserializer()
+	var objects []runtime.Object
+	var collected time.Time
+	sections := map[string]map[string]string{} // Log text by pod and section
+	manifests, skipped := 0, 0
+
+	err := walkDump(path, func(name string, modTime time.Time, r io.Reader) error {
+		if modTime.After(collected) {
+			collected = modTime
+		}
+		if pod, section, ok := dumpLogFile(name); ok {
+			text, err := readTail(r, dumpLogLimit)
+			if err != nil {
+				return fmt.Errorf("%s: %v", name, err)
+			}
+			if sections[pod] == nil {
+				sections[pod] = map[string]string{}
+			}
+			sections[pod][section] = text
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(name)) {
+		case ".yaml", ".yml", ".json":
+		default:
+			return nil
+		}
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		decoded, err := decodeDumpObjects(decoder, data)
+		if err != nil {
+			// Kinds this client has no types for, e.g. OpenShift ones, and files that are not API objects
+			skipped++
+			return nil
+		}
+		manifests++
+		objects = append(objects, decoded...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if manifests == 0 {
+		return nil, fmt.Errorf("no Kubernetes objects found; expected a must-gather or 'kubectl cluster-info dump' directory or archive")
+	}
+	if collected.IsZero() {
+		collected = time.Now()
+	}
+
+	frame := SnapshotFrame{Time: collected.UTC(), Logs: map[string]string{}}
+	for pod, parts := range sections {
+		frame.Logs[pod] = joinLogSections(parts)
+	}
+	name := filepath.Base(filepath.Clean(path))
+	replay := newReplayState(path, &Snapshot{Version: snapshotVersion, Context: name, Frames: []SnapshotFrame{frame}})
+	replay.dump = true
+	cs, err := offlineClientset(objects)
+	if err != nil {
+		return nil, err
+	}
+	replay.clientsets[0] = cs
+	replay.notice = fmt.Sprintf("Loaded %d manifest file(s) and logs of %d pod(s) from %s", manifests, len(frame.Logs), name)
+	if skipped > 0 {
+		replay.notice += fmt.Sprintf("; %d file(s) of other kinds skipped", skipped)
+	}
+	return replay, nil
+}
+
+// walkDump calls visit for every regular file of a directory, or of a tar archive that may be gzip-compressed
+func walkDump(path string, visit func(name string, modTime time.Time, r io.Reader) error) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return filepath.WalkDir(path, func(file string, entry fs.DirEntry, err error) error {
+			if err != nil || !entry.Type().IsRegular() {
+				return err
+			}
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+			f, err := os.Open(file)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			rel, _ := filepath.Rel(path, file)
+			return visit(rel, info.ModTime(), f)
+		})
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	buffered := bufio.NewReader(file)
+	var r io.Reader = buffered
+	if magic, _ := buffered.Peek(2); len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+	archive := tar.NewReader(r)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("not a directory or tar archive: %v", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err := visit(header.Name, header.ModTime, archive); err != nil {
+			return err
+		}
+	}
+}
+
+// dumpLogFile recognizes pod log files: must-gather's namespaces/<ns>/pods/<pod>/<container>/<container>/logs/current.log
+// (and previous.log) and cluster-info dump's <ns>/<pod>/logs.txt; pod is namespace/name
+func dumpLogFile(name string) (pod, section string, ok bool) {
+	parts := strings.Split(filepath.ToSlash(name), "/")
+	n := len(parts)
+	if n >= 8 && parts[n-8] == "namespaces" && parts[n-6] == "pods" && parts[n-2] == "logs" {
+		which := strings.TrimSuffix(parts[n-1], ".log")
+		if which != "current" && which != "previous" {
+			return "", "", false
+		}
+		return parts[n-7] + "/" + parts[n-5], parts[n-4] + "/" + which, true
+	}
+	if n >= 3 && parts[n-1] == "logs.txt" {
+		return parts[n-3] + "/" + parts[n-2], "", true
+	}
+	return "", "", false
+}
+
+// joinLogSections joins the log files of a pod, each container's previous logs before its current ones
+func joinLogSections(parts map[string]string) string {
+	keys := make([]string, 0, len(parts))
+	for key := range parts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		ci, wi, _ := strings.Cut(keys[i], "/")
+		cj, wj, _ := strings.Cut(keys[j], "/")
+		if ci != cj {
+			return ci < cj
+		}
+		return wi == "previous" && wj != "previous"
+	})
+
+	var text strings.Builder
+	for _, key := range keys {
+		if container, which, ok := strings.Cut(key, "/"); ok {
+			text.WriteString(fmt.Sprintf("==== %s logs of container %s ====\n", which, container))
+		}
+		text.WriteString(parts[key])
+	}
+	return text.String()
+}
+
+// readTail reads r and keeps its last limit bytes, starting at a line; files of a dump directory are
+// read from size-limit on, archive members through a ring buffer, so memory stays bounded by limit
+func readTail(r io.Reader, limit int) (string, error) {
+	var data []byte
+	truncated := false
+	if file, ok := r.(io.Seeker); ok {
+		size, err := file.Seek(0, io.SeekEnd)
+		if err != nil {
+			return "", err
+		}
+		start := size - int64(limit)
+		if start < 0 {
+			start = 0
+		}
+		if _, err := file.Seek(start, io.SeekStart); err != nil {
+			return "", err
+		}
+		if data, err = io.ReadAll(io.LimitReader(r, int64(limit))); err != nil {
+			return "", err
+		}
+		truncated = start > 0
+	} else {
+		ring := make([]byte, limit)
+		chunk := make([]byte, 32<<10)
+		pos, total := 0, int64(0)
+		for {
+			n, err := r.Read(chunk)
+			total += int64(n)
+			for p := chunk[:n]; len(p) > 0; {
+				copied := copy(ring[pos:], p)
+				pos = (pos + copied) % limit
+				p = p[copied:]
+			}
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return "", err
+			}
+		}
+		if total <= int64(limit) {
+			data = ring[:total]
+		} else {
+			data = append(ring[pos:], ring[:pos]...)
+			truncated = true
+		}
+	}
+	if truncated {
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			data = data[i+1:]
+		}
+	}
+	return string(data), nil
+}
+
+// decodeDumpObjects decodes an API object, or the items of a list; items of unknown kinds are dropped
+func decodeDumpObjects(decoder runtime.Decoder, data []byte) ([]runtime.Object, error) {
+	obj, _, err := decoder.Decode(data, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !meta.IsListType(obj) {
+		return []runtime.Object{obj}, nil
+	}
+	items, err := meta.ExtractList(obj)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]runtime.Object, 0, len(items))
+	for _, item := range items {
+		if unknown, ok := item.(*runtime.Unknown); ok {
+			// Items of a generic v1 List
+			if item, _, err = decoder.Decode(unknown.Raw, nil, nil); err != nil {
+				continue
+			}
+		}
+		objects = append(objects, item)
+	}
+	return objects, nil
+}
+
+// compareSide is one side of a drift comparison: a namespace in a context
+type compareSide struct {
+	Context   string
+	Namespace string
+}
+
+func (s compareSide) String() string {
+	return s.Context + "/" + s.Namespace
+}
+
+// Comparison is the drift between the objects of two namespaces, possibly in different contexts
+type Comparison struct {
+	Left       compareSide
+	Right      compareSide
+	Kinds      []ResourceType
+	Rows       []DriftRow // Objects that differ or exist on one side only
+	Identical  int
+	Errors     []string // Kinds that could not be listed on a side; they are left out on both
+	ComparedAt time.Time
+}
+
+// DriftRow is an object that differs between the sides of a comparison
+type DriftRow struct {
+	Kind    ResourceType
+	Name    string
+	InLeft  bool
+	InRight bool
+	Fields  []FieldChange // Old is the left value, New the right one; "" when the field is absent
+	Areas   []string      // Spec areas that differ: images, replicas, env, resources, other
+	Same    []string      // Secret keys with the same value on both sides
+}
+
+// row returns the row of an object, if it still differs
+func (c *Comparison) row(kind ResourceType, name string) (DriftRow, bool) {
+	if c == nil {
+		return DriftRow{}, false
+	}
+	for _, row := range c.Rows {
+		if row.Kind == kind && row.Name == name {
+			return row, true
+		}
+	}
+	return DriftRow{}, false
+}
+
+// diffedRow returns the comparison row shown in CompareDiffView
+func (m Model) diffedRow() (DriftRow, bool) {
+	return m.comparison.row(m.diffKind, m.diffName)
+}
+
+// driftKinds are the kinds a comparison can include
+var driftKinds = map[ResourceType]func(m Model, namespace string) (runtime.Object, error){
+	DeploymentsResource: func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.AppsV1().Deployments(namespace).List(m.ctx, metav1.ListOptions{})
+	},
+	StatefulSetsResource: func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.AppsV1().StatefulSets(namespace).List(m.ctx, metav1.ListOptions{})
+	},
+	DaemonSetsResource: func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.AppsV1().DaemonSets(namespace).List(m.ctx, metav1.ListOptions{})
+	},
+	CronJobsResource: func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.BatchV1().CronJobs(namespace).List(m.ctx, metav1.ListOptions{})
+	},
+	JobsResource: func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.BatchV1().Jobs(namespace).List(m.ctx, metav1.ListOptions{})
+	},
+	ServicesResource: func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.CoreV1().Services(namespace).List(m.ctx, metav1.ListOptions{})
+	},
+	ConfigMapsResource: func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.CoreV1().ConfigMaps(namespace).List(m.ctx, metav1.ListOptions{})
+	},
+	SecretsResource: func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.CoreV1().Secrets(namespace).List(m.ctx, metav1.ListOptions{})
+	},
+	IngressResource: func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.NetworkingV1().Ingresses(namespace).List(m.ctx, metav1.ListOptions{})
+	},
+	PersistentVolumeClaimsResource: func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.CoreV1().PersistentVolumeClaims(namespace).List(m.ctx, metav1.ListOptions{})
+	},
+	NetworkPoliciesResource: func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.NetworkingV1().NetworkPolicies(namespace).List(m.ctx, metav1.ListOptions{})
+	},
+	HorizontalPodAutoscalersResource: func(m Model, namespace string) (runtime.Object, error) {
+		return m.clientset.AutoscalingV2().HorizontalPodAutoscalers(namespace).List(m.ctx, metav1.ListOptions{})
+	},
+}
+
+// defaultDriftKinds are compared when a comparison names no kinds
+var defaultDriftKinds = []ResourceType{DeploymentsResource, StatefulSetsResource, DaemonSetsResource, CronJobsResource,
+	ServicesResource, ConfigMapsResource, SecretsResource, IngressResource}
+
+// driftIgnoredFields are removed before comparing: state owned by the API server, controllers or the cluster
+var driftIgnoredFields = [][]string{
+	{"status"},
+	{"metadata", "uid"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "generation"},
+	{"metadata", "creationTimestamp"},
+	{"metadata", "managedFields"},
+	{"metadata", "selfLink"},
+	{"metadata", "namespace"},
+	{"metadata", "ownerReferences"},
+	{"metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration"},
+	{"metadata", "annotations", "deployment.kubernetes.io/revision"},
+	{"spec", "template", "metadata", "creationTimestamp"},
+	{"spec", "template", "metadata", "annotations", "kubectl.kubernetes.io/restartedAt"},
+	{"spec", "jobTemplate", "metadata", "creationTimestamp"},
+	{"spec", "jobTemplate", "spec", "template", "metadata", "creationTimestamp"},
+	{"spec", "clusterIP"},
+	{"spec", "clusterIPs"},
+	{"spec", "ports", "*", "nodePort"},
+	{"spec", "healthCheckNodePort"},
+	{"spec", "volumeName"},
+	{"metadata", "labels", "controller-uid"},
+	{"metadata", "labels", "batch.kubernetes.io/controller-uid"},
+	{"spec", "selector", "matchLabels", "controller-uid"},
+	{"spec", "selector", "matchLabels", "batch.kubernetes.io/controller-uid"},
+	{"spec", "template", "metadata", "labels", "controller-uid"},
+	{"spec", "template", "metadata", "labels", "batch.kubernetes.io/controller-uid"},
+}
+
+// driftIgnoredConfigMaps are published into every namespace by the cluster, with its own CA
+var driftIgnoredConfigMaps = []string{"kube-root-ca.crt", "openshift-service-ca.crt"}
+
+// removeDriftField deletes path from an object; a "*" element stands for every item of a list
+func removeDriftField(value interface{}, path []string) {
+	fields, ok := value.(map[string]interface{})
+	if !ok || len(path) == 0 {
+		return
+	}
+	switch {
+	case len(path) == 1:
+		delete(fields, path[0])
+	case path[1] == "*":
+		items, _ := fields[path[0]].([]interface{})
+		for _, item := range items {
+			removeDriftField(item, path[2:])
+		}
+	default:
+		removeDriftField(fields[path[0]], path[1:])
+	}
+}
+
+// secretValueField reports whether a flattened Secret field holds a value, which is compared by hash only
+func secretValueField(path string) bool {
+	for _, key := range []string{"data", "stringData"} {
+		if strings.HasPrefix(path, key+".") || strings.HasPrefix(path, key+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+// driftFields lists one kind in a namespace and flattens each object, by name, to its compared fields
+func driftFields(m Model, rt ResourceType, namespace string) (map[string]map[string]string, error) {
+	list, err := driftKinds[rt](m, namespace)
+	if err != nil {
+		return nil, err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	objects := map[string]map[string]string{}
+	for _, item := range items {
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(item)
+		if err != nil {
+			return nil, err
+		}
+		name, _, _ := unstructured.NestedString(obj, "metadata", "name")
+		secretType, _, _ := unstructured.NestedString(obj, "type")
+		if rt == ConfigMapsResource && containsString(driftIgnoredConfigMaps, name) ||
+			rt == SecretsResource && secretType == string(corev1.SecretTypeServiceAccountToken) {
+			continue // Generated per cluster
+		}
+		for _, path := range driftIgnoredFields {
+			removeDriftField(obj, path)
+		}
+		if rt == SecretsResource {
+			// Compare secret values by hash; renderDriftDiff never shows them
+			for _, key := range []string{"data", "stringData"} {
+				values, _, _ := unstructured.NestedMap(obj, key)
+				for name, value := range values {
+					values[name] = fmt.Sprintf("%x", sha256.Sum256([]byte(fmt.Sprint(value))))
+				}
+				if len(values) > 0 {
+					unstructured.SetNestedMap(obj, values, key)
+				}
+			}
+		}
+		fields := map[string]string{}
+		flattenFields("", obj, fields)
+		objects[name] = fields
+	}
+	return objects, nil
+}
+
+// flattenFields turns an object into field paths and their values; items of lists of named objects are keyed
+// by name, e.g. spec.template.spec.containers[app].image, so that reordering them is not a difference
+func flattenFields(prefix string, value interface{}, out map[string]string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			path := key
+			if strings.ContainsAny(key, "./") {
+				path = prefix + "[" + key + "]"
+			} else if prefix != "" {
+				path = prefix + "." + key
+			}
+			flattenFields(path, item, out)
+		}
+	case []interface{}:
+		named := true
+		for _, item := range v {
+			fields, ok := item.(map[string]interface{})
+			if _, hasName := fields["name"].(string); !ok || !hasName {
+				named = false
+				break
+			}
+		}
+		for i, item := range v {
+			key := strconv.Itoa(i)
+			if named {
+				key = item.(map[string]interface{})["name"].(string)
+			}
+			flattenFields(fmt.Sprintf("%s[%s]", prefix, key), item, out)
+		}
+	case nil:
+	default:
+		out[prefix] = fmt.Sprint(v)
+	}
+}
+
+// diffFields returns the fields whose values differ, sorted by path; a nil side has no fields
+func diffFields(left, right map[string]string) []FieldChange {
+	var changes []FieldChange
+	for path, value := range left {
+		if right[path] != value {
+			changes = append(changes, FieldChange{Field: path, Old: value, New: right[path]})
+		}
+	}
+	for path, value := range right {
+		if _, ok := left[path]; !ok {
+			changes = append(changes, FieldChange{Field: path, New: value})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	return changes
+}
+
+// driftArea names the part of a spec a field belongs to
+func driftArea(path string) string {
+	switch {
+	case strings.HasSuffix(path, ".image"):
+		return "images"
+	case strings.HasSuffix(strings.ToLower(path), "replicas"):
+		return "replicas"
+	case strings.Contains(path, ".env[") || strings.Contains(path, ".envFrom["):
+		return "env"
+	case strings.Contains(path, ".resources."):
+		return "resources"
+	}
+	return "other"
+}
+
+// diffObjects compares the objects of one kind by name
+func diffObjects(rt ResourceType, left, right map[string]map[string]string) ([]DriftRow, int) {
+	names := map[string]bool{}
+	for name := range left {
+		names[name] = true
+	}
+	for name := range right {
+		names[name] = true
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	var rows []DriftRow
+	identical := 0
+	for _, name := range sorted {
+		l, inLeft := left[name]
+		r, inRight := right[name]
+		row := DriftRow{Kind: rt, Name: name, InLeft: inLeft, InRight: inRight, Fields: diffFields(l, r)}
+		if inLeft && inRight {
+			if len(row.Fields) == 0 {
+				identical++
+				continue
+			}
+			if rt == SecretsResource {
+				for path, value := range l {
+					if secretValueField(path) && r[path] == value {
+						row.Same = append(row.Same, path)
+					}
+				}
+				sort.Strings(row.Same)
+			}
+			seen := map[string]bool{}
+			for _, area := range []string{"images", "replicas", "env", "resources", "other"} {
+				for _, field := range row.Fields {
+					if driftArea(field.Field) == area && !seen[area] {
+						seen[area] = true
+						row.Areas = append(row.Areas, area)
+					}
+				}
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, identical
+}
+
+// comparisonLoadedMsg carries the result of loadComparison
+type comparisonLoadedMsg struct {
+	comparison *Comparison
+	err        error
+}
+
+// sideModel returns a model connected to the context of a side
+func (m Model) sideModel(side compareSide) (Model, error) {
+	if side.Context == m.selectedKubeContext {
+		return m, nil
+	}
+	if m.replay != nil {
+		return m, fmt.Errorf("only the recorded context %s is available", m.selectedKubeContext)
+	}
+	clients := connectContext(side.Context)
+	if clients.err != nil {
+		return m, clients.err
+	}
+	m.clientset = clients.clientset
+	return m, nil
+}
+
+// loadComparison lists kinds on both sides and compares their objects
+func (m Model) loadComparison(left, right compareSide, kinds []ResourceType) tea.Cmd {
+	return func() tea.Msg {
+		result := &Comparison{Left: left, Right: right, Kinds: kinds, ComparedAt: time.Now()}
+		sides := []compareSide{left, right}
+		models := make([]Model, len(sides))
+		for i, side := range sides {
+			sm, err := m.sideModel(side)
+			if err != nil {
+				return comparisonLoadedMsg{err: fmt.Errorf("context %s: %v", side.Context, err)}
+			}
+			models[i] = sm
+		}
+
+		for _, rt := range kinds {
+			objects := make([]map[string]map[string]string, len(sides))
+			listed := true
+			for i, side := range sides {
+				fields, err := driftFields(models[i], rt, side.Namespace)
+				if err != nil {
+					result.Errors = append(result.Errors, fmt.Sprintf("%s in %s: %v", rt, side, err))
+					listed = false
+					break
+				}
+				objects[i] = fields
+			}
+			if !listed {
+				continue
+			}
+			rows, identical := diffObjects(rt, objects[0], objects[1])
+			result.Rows = append(result.Rows, rows...)
+			result.Identical += identical
+		}
+		return comparisonLoadedMsg{comparison: result}
+	}
+}
+
+// parseCompareArgs resolves ':compare [LEFT] RIGHT [KINDS]'; a side is CONTEXT/NAMESPACE, CONTEXT (same
+// namespace) or /NAMESPACE (same context), and LEFT defaults to the current context and namespace
+func (m Model) parseCompareArgs(args []string) (compareSide, compareSide, []ResourceType, error) {
+	usage := fmt.Errorf("usage: :compare [CONTEXT/NAMESPACE] CONTEXT/NAMESPACE [KINDS], e.g. :compare prod/payments deploy,svc")
+	if len(args) == 0 {
+		return compareSide{}, compareSide{}, nil, usage
+	}
+	kinds := defaultDriftKinds
+	if last := args[len(args)-1]; len(args) > 1 && !strings.Contains(last, "/") && !containsString(m.kubernetesContexts, last) {
+		kinds = nil
+		for _, name := range splitList(last) {
+			rt, ok := m.kindAliases()[strings.ToLower(name)]
+			if !ok {
+				return compareSide{}, compareSide{}, nil, fmt.Errorf("unknown kind '%s'", name)
+			}
+			if driftKinds[rt] == nil {
+				var supported []string
+				for rt := range driftKinds {
+					_, resource := rt.apiResource()
+					supported = append(supported, resource)
+				}
+				sort.Strings(supported)
+				return compareSide{}, compareSide{}, nil, fmt.Errorf("%s cannot be compared; kinds: %s", rt, strings.Join(supported, ", "))
+			}
+			kinds = append(kinds, rt)
+		}
+		args = args[:len(args)-1]
+	}
+	if len(args) > 2 {
+		return compareSide{}, compareSide{}, nil, usage
+	}
+
+	current := compareSide{Context: m.selectedKubeContext}
+	if m.selectedScope == NamespaceScoped {
+		current.Namespace = m.selectedNamespace
+	}
+	left := current
+	var err error
+	if len(args) == 2 {
+		if left, err = m.parseCompareSide(args[0], current); err != nil {
+			return compareSide{}, compareSide{}, nil, err
+		}
+	}
+	right, err := m.parseCompareSide(args[len(args)-1], left)
+	if err != nil {
+		return compareSide{}, compareSide{}, nil, err
+	}
+	for _, side := range []compareSide{left, right} {
+		if side.Context == "" {
+			return compareSide{}, compareSide{}, nil, fmt.Errorf("no context selected; name one as CONTEXT/NAMESPACE")
+		}
+		if side.Namespace == "" {
+			return compareSide{}, compareSide{}, nil, fmt.Errorf("no namespace selected for %s; name one as CONTEXT/NAMESPACE", side.Context)
+		}
+	}
+	if left == right {
+		return compareSide{}, compareSide{}, nil, fmt.Errorf("both sides are %s", left)
+	}
+	return left, right, kinds, nil
+}
+
+// parseCompareSide resolves one side; missing parts come from base. Context names may contain '/', so a known
+// context name is taken whole and otherwise the namespace follows the last '/'
+func (m Model) parseCompareSide(value string, base compareSide) (compareSide, error) {
+	side := base
+	if containsString(m.kubernetesContexts, value) {
+		side.Context = value
+		return side, nil
+	}
+	i := strings.LastIndex(value, "/")
+	if i < 0 {
+		return side, fmt.Errorf("unknown context '%s'", value)
+	}
+	if context := value[:i]; context != "" {
+		if !containsString(m.kubernetesContexts, context) {
+			return side, fmt.Errorf("unknown context '%s'", context)
+		}
+		side.Context = context
+	}
+	side.Namespace = value[i+1:]
+	return side, nil
+}
+
+// openComparison starts comparing two sides in CompareView
+func (m Model) openComparison(left, right compareSide, kinds []ResourceType) (tea.Model, tea.Cmd) {
+	m.viewStack = append(m.viewStack, m.currentView)
+	m.currentView = CompareView
+	m.comparison = &Comparison{Left: left, Right: right, Kinds: kinds}
+	m.cursor = 0
+	m.loading = true
+	return m, m.loadComparison(left, right, kinds)
+}
+
+// openDriftDiff shows the field-level diff of the comparison row under the cursor
+func (m Model) openDriftDiff() (tea.Model, tea.Cmd) {
+	if m.comparison == nil || m.cursor >= len(m.comparison.Rows) {
+		return m, nil
+	}
+	row := m.comparison.Rows[m.cursor]
+	m.diffKind, m.diffName = row.Kind, row.Name
+	m.viewStack = append(m.viewStack, m.currentView)
+	m.currentView = CompareDiffView
+	m.cursor = 0
+	return m, nil
+}
+
+// renderComparison shows the objects that differ between the two sides, side by side
+func (m Model) renderComparison() string {
+	var content strings.Builder
+	headerStyle := lipgloss.NewStyle().Foreground(colors.Success).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(colors.Text)
+	selectedStyle := colors.selection(colors.Secondary)
+	mutedStyle := lipgloss.NewStyle().Foreground(colors.Muted)
+	errorStyle := lipgloss.NewStyle().Foreground(colors.Error)
+	warningStyle := lipgloss.NewStyle().Foreground(colors.Warning)
+
+	c := m.comparison
+	if c == nil {
+		return normalStyle.Render("No comparison yet; run :compare CONTEXT/NAMESPACE") + "\n"
+	}
+	content.WriteString(headerStyle.Render(fmt.Sprintf("⚖️  Drift: %s ↔ %s", c.Left, c.Right)) + "\n")
+	var kinds []string
+	for _, rt := range c.Kinds {
+		kinds = append(kinds, rt.String())
+	}
+	content.WriteString(mutedStyle.Render("Kinds: "+strings.Join(kinds, ", ")) + "\n")
+	if c.ComparedAt.IsZero() {
+		return content.String()
+	}
+
+	differ, onlyLeft, onlyRight := 0, 0, 0
+	for _, row := range c.Rows {
+		switch {
+		case !row.InRight:
+			onlyLeft++
+		case !row.InLeft:
+			onlyRight++
+		default:
+			differ++
+		}
+	}
+	content.WriteString(mutedStyle.Render(fmt.Sprintf("%d differ · %d only in %s · %d only in %s · %d identical · status, uid and resourceVersion ignored",
+		differ, onlyLeft, c.Left, onlyRight, c.Right, c.Identical)) + "\n")
+	for _, problem := range c.Errors {
+		content.WriteString(errorStyle.Render("❌ Not compared: "+problem) + "\n")
+	}
+	content.WriteString("\n")
+	if len(c.Rows) == 0 {
+		content.WriteString(normalStyle.Render("No drift: both sides have the same objects and specs") + "\n")
+		return content.String()
+	}
+
+	sideWidth := min(max(len(c.Left.String()), len(c.Right.String()), 8), 24)
+	nameWidth := 32
+	for _, row := range c.Rows {
+		nameWidth = min(max(nameWidth, len(row.Name)), 48)
+	}
+	rowFormat := fmt.Sprintf("%%-%ds %%-%ds %%-%ds %%-%ds %%s", 13, nameWidth, sideWidth, sideWidth)
+	content.WriteString(mutedStyle.Render("  "+fmt.Sprintf(rowFormat, "KIND", "NAME",
+		truncateString(c.Left.String(), sideWidth), truncateString(c.Right.String(), sideWidth), "DIFFERENCES")) + "\n")
+
+	matches := m.filterMatches()
+	visible := max(m.height-18, 5)
+	start := 0
+	if m.cursor >= visible {
+		start = m.cursor - visible + 1
+	}
+	for i := start; i < len(c.Rows) && i < start+visible; i++ {
+		if matches != nil && !matches[i] {
+			continue
+		}
+		row := c.Rows[i]
+		presence := func(present bool) string {
+			if present {
+				return "✓"
+			}
+			return "—"
+		}
+		style := warningStyle
+		summary := fmt.Sprintf("%s (%d field(s))", strings.Join(row.Areas, ", "), len(row.Fields))
+		switch {
+		case !row.InRight:
+			style, summary = errorStyle, "only in "+c.Left.String()
+		case !row.InLeft:
+			style, summary = errorStyle, "only in "+c.Right.String()
+		}
+		line := fmt.Sprintf(rowFormat, kindLabel(row.Kind), truncateString(row.Name, nameWidth),
+			presence(row.InLeft), presence(row.InRight), summary)
+		line = truncateString(line, max(m.width-4, 40))
+		if i == m.cursor {
+			content.WriteString("▶ " + selectedStyle.Render(line) + "\n")
+		} else {
+			content.WriteString("  " + style.Render(line) + "\n")
+		}
+	}
+	return content.String()
+}
+
+// renderDriftDiff shows the field-level differences of one object, left and right values side by side
+func (m Model) renderDriftDiff() string {
+	var content strings.Builder
+	headerStyle := lipgloss.NewStyle().Foreground(colors.Success).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(colors.Text)
+	selectedStyle := colors.selection(colors.Secondary)
+	mutedStyle := lipgloss.NewStyle().Foreground(colors.Muted)
+	warningStyle := lipgloss.NewStyle().Foreground(colors.Warning)
+
+	c := m.comparison
+	row, ok := m.diffedRow()
+	if !ok {
+		return normalStyle.Render("Nothing to compare") + "\n"
+	}
+	content.WriteString(headerStyle.Render(fmt.Sprintf("🔍 %s %s", kindLabel(row.Kind), row.Name)) + "\n")
+	note := fmt.Sprintf("%s ↔ %s · %d field(s) differ", c.Left, c.Right, len(row.Fields))
+	switch {
+	case !row.InRight:
+		note = fmt.Sprintf("Only in %s; missing in %s", c.Left, c.Right)
+	case !row.InLeft:
+		note = fmt.Sprintf("Only in %s; missing in %s", c.Right, c.Left)
+	}
+	content.WriteString(mutedStyle.Render(note+" · status, uid and resourceVersion ignored") + "\n\n")
+
+	width := max(m.width-4, 60)
+	fieldWidth := min(width*2/5, 60)
+	valueWidth := max((width-fieldWidth-2)/2, 10)
+	rowFormat := fmt.Sprintf("%%-%ds %%-%ds %%s", fieldWidth, valueWidth)
+	content.WriteString(mutedStyle.Render("  "+fmt.Sprintf(rowFormat, "FIELD",
+		truncateString(c.Left.String(), valueWidth), truncateString(c.Right.String(), valueWidth))) + "\n")
+
+	secret := func(field FieldChange) bool {
+		return row.Kind == SecretsResource && secretValueField(field.Field)
+	}
+	value := func(v string) string {
+		if v == "" {
+			return "—"
+		}
+		return truncateString(strings.ReplaceAll(v, "\n", "⏎"), valueWidth)
+	}
+	shown := func(field FieldChange, v string) string {
+		if secret(field) && v != "" {
+			return "differs" // Only the hashes are compared
+		}
+		return value(v)
+	}
+	visible := max(m.height-18, 5)
+	start := 0
+	if m.cursor >= visible {
+		start = m.cursor - visible + 1
+	}
+	for i := start; i < len(row.Fields) && i < start+visible; i++ {
+		field := row.Fields[i]
+		line := fmt.Sprintf(rowFormat, truncateString(field.Field, fieldWidth), shown(field, field.Old), shown(field, field.New))
+		if i == m.cursor {
+			content.WriteString("▶ " + selectedStyle.Render(line) + "\n")
+		} else if row.InLeft && row.InRight {
+			content.WriteString("  " + warningStyle.Render(line) + "\n")
+		} else {
+			content.WriteString("  " + normalStyle.Render(line) + "\n")
+		}
+	}
+	if len(row.Same) > 0 {
+		content.WriteString("\n" + mutedStyle.Render(truncateString("Same values: "+strings.Join(row.Same, ", "), width)) + "\n")
+	}
+	if i := m.cursor; i < len(row.Fields) && !secret(row.Fields[i]) && (len(row.Fields[i].Old) > valueWidth || len(row.Fields[i].New) > valueWidth) {
+		// Long values of the selected field in full, wrapped to the screen
+		full := func(v string) string {
+			if v == "" {
+				return "—"
+			}
+			return normalStyle.Width(width).Render(v)
+		}
+		content.WriteString("\n" + mutedStyle.Render(c.Left.String()+":") + "\n" + full(row.Fields[i].Old) + "\n")
+		content.WriteString(mutedStyle.Render(c.Right.String()+":") + "\n" + full(row.Fields[i].New) + "\n")
+	}
+	return content.String()
+}
+
+// rulesFilePath returns the user rules file: $K8SGO_RULES or ~/.config/k8sgo/rules.yaml
+func rulesFilePath() string {
+	if path := os.Getenv("K8SGO_RULES"); path != "" {
+		return path
+	}
+	return filepath.Join(homedir.HomeDir(), ".config", "k8sgo", "rules.yaml")
+}
+
+// loadRuleSet compiles the built-in rules and the rules in path (if it exists)
+func loadRuleSet(path string) (*RuleSet, error) {
+	rules := make([]HealthRule, len(builtinRules))
+	copy(rules, builtinRules)
+	
+	if data, err := os.ReadFile(path); err == nil {
+		var file rulesFile
+		if err := yaml.UnmarshalStrict(data, &file); err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+		for _, custom := range file.Rules {
+			replaced := false
+			for i := range rules {
+				if rules[i].Name == custom.Name {
+					rules[i] = custom
+					replaced = true
+				}
+			}
+			if !replaced {
+				rules = append(rules, custom)
+			}
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	
+	set := &RuleSet{}
+	for i := range rules {
+		rule := rules[i]
+		if rule.Disabled {
+			continue
+		}
+		if err := rule.compile(); err != nil {
+			return nil, fmt.Errorf("%s: rule %q: %v", path, rule.Name, err)
+		}
+		set.rules = append(set.rules, &rule)
+	}
+	return set, nil
+}
+
+// compile validates a rule and parses its expression and message
+func (r *HealthRule) compile() error {
+	if r.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if r.Severity != "error" && r.Severity != "warning" {
+		return fmt.Errorf("severity must be \"error\" or \"warning\", got %q", r.Severity)
+	}
+	if len(r.Kinds) == 0 {
+		return fmt.Errorf("kinds is required (e.g. [pods] or [\"*\"])")
+	}
+	expr, err := parseRuleExpr(r.Expr)
+	if err != nil {
+		return fmt.Errorf("expr: %v", err)
+	}
+	r.expr = expr
+	
+	message := r.Message
+	if message == "" {
+		message = r.Name
+	}
+	r.message, err = template.New(r.Name).Option("missingkey=zero").Parse(message)
+	if err != nil {
+		return fmt.Errorf("message: %v", err)
+	}
+	return nil
+}
+
+// appliesTo reports whether the rule checks objects of the given resource name
+func (r *HealthRule) appliesTo(resourceName string) bool {
+	for _, kind := range r.Kinds {
+		if kind == "*" || strings.EqualFold(kind, resourceName) {
+			return true
+		}
+	}
+	return false
+}
+
+// ruleMessageData is the data passed to rule message templates
+type ruleMessageData struct {
+	Name      string
+	Namespace string
+	object    map[string]interface{}
+	now       time.Time
+}
+
+// Eval evaluates an expression against the object for use in messages
+func (d ruleMessageData) Eval(expr string) (string, error) {
+	node, err := parseRuleExpr(expr)
+	if err != nil {
+		return "", err
+	}
+	var values []string
+	for _, v := range node.eval(d.object, d.now) {
+		values = append(values, fmt.Sprint(v))
+	}
+	return strings.Join(values, ","), nil
+}
+
+// apply evaluates the rules against resources with a live object at time now and records their findings;
+// applying again, e.g. once usage metrics are attached, replaces the earlier findings
+func (rs *RuleSet) apply(resources []K8sResource, now time.Time) {
+	if rs == nil {
+		return
+	}
+	for i := range resources {
+		res := &resources[i]
+		res.Findings, res.Errors, res.Warnings = nil, nil, nil
+		if res.Object == nil {
+			continue
+		}
+		_, resourceName := res.ResourceType.apiResource()
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(res.Object)
+		if err != nil {
+			continue
+		}
+		for key, value := range res.RuleData {
+			obj[key] = value
+		}
+		if res.Usage != nil {
+			obj["usage"] = res.Usage.ruleData()
+		}
+		
+		for _, rule := range rs.rules {
+			if !rule.appliesTo(resourceName) || !anyTruthy(rule.expr.eval(obj, now)) {
+				continue
+			}
+			
+			var message strings.Builder
+			data := ruleMessageData{Name: res.Name, Namespace: res.Namespace, object: obj, now: now}
+			if err := rule.message.Execute(&message, data); err != nil {
+				message.Reset()
+				message.WriteString(rule.Name)
+			}
+			
+			res.Findings = append(res.Findings, RuleFinding{Rule: rule.Name, Severity: rule.Severity, Message: message.String()})
+			text := fmt.Sprintf("%s [%s: %s]", message.String(), rule.Severity, rule.Name)
+			if rule.Severity == "error" {
+				res.Errors = append(res.Errors, text)
+			} else {
+				res.Warnings = append(res.Warnings, text)
+			}
+		}
+	}
+}
+
+// Rule expressions
+//
+//	expr    := and ("||" and)*
+//	and     := unary ("&&" unary)*
+//	unary   := "!" unary | compare
+//	compare := operand [("==" | "!=" | ">" | ">=" | "<" | "<=" | "=~") operand]
+//	operand := number | 'string' | "string" | true | false | null
+//	         | func "(" [expr ("," expr)*] ")" | path | "(" expr ")"
+//	path    := ident ("." ident | "[" (number | string | "*" | expr) "]")*
+//
+// Paths may select several values ([*] walks every element, [expr] keeps the list elements
+// for which expr, evaluated on the element, is true); a comparison is true when any pair
+// of values satisfies it. Functions: len, count, sum, exists, default,
+// quantity (parses "500Mi", "250m", ...) and age (seconds since creation).
+
+// ruleNode is a parsed rule expression
+type ruleNode interface {
+	eval(obj map[string]interface{}, now time.Time) []interface{}
+}
+
+type ruleLiteral struct{ value interface{} }
+
+type rulePathStep struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+	filter   ruleNode // Keeps the list elements it is true for
+}
+
+type rulePath struct{ steps []rulePathStep }
+
+type ruleNot struct{ x ruleNode }
+
+type ruleLogical struct {
+	and         bool
+	left, right ruleNode
+}
+
+type ruleCompare struct {
+	op          string
+	left, right ruleNode
+	pattern     *regexp.Regexp
+}
+
+type ruleCall struct {
+	name string
+	args []ruleNode
+}
+
+func (n ruleLiteral) eval(map[string]interface{}, time.Time) []interface{} {
+	return []interface{}{n.value}
+}
+
+func (n rulePath) eval(obj map[string]interface{}, now time.Time) []interface{} {
+	current := []interface{}{obj}
+	for _, step := range n.steps {
+		var next []interface{}
+		for _, value := range current {
+			switch v := value.(type) {
+			case map[string]interface{}:
+				if step.wildcard {
+					for _, item := range v {
+						next = append(next, item)
+					}
+				} else if item, ok := v[step.key]; ok && !step.isIndex {
+					next = append(next, item)
+				}
+			case []interface{}:
+				if step.filter != nil {
+					for _, item := range v {
+						if element, ok := item.(map[string]interface{}); ok && anyTruthy(step.filter.eval(element, now)) {
+							next = append(next, item)
+						}
+					}
+				} else if step.wildcard {
+					next = append(next, v...)
+				} else if step.isIndex && step.index >= 0 && step.index < len(v) {
+					next = append(next, v[step.index])
+				}
+			}
+		}
+		current = next
+	}
+	return current
+}
+
+func (n ruleNot) eval(obj map[string]interface{}, now time.Time) []interface{} {
+	return []interface{}{!anyTruthy(n.x.eval(obj, now))}
+}
+
+func (n ruleLogical) eval(obj map[string]interface{}, now time.Time) []interface{} {
+	left := anyTruthy(n.left.eval(obj, now))
+	if n.and && !left || !n.and && left {
+		return []interface{}{left}
+	}
+	return []interface{}{anyTruthy(n.right.eval(obj, now))}
+}
+
+func (n ruleCompare) eval(obj map[string]interface{}, now time.Time) []interface{} {
+	lefts := n.left.eval(obj, now)
+	if n.pattern != nil {
+		for _, l := range lefts {
+			if s, ok := l.(string); ok && n.pattern.MatchString(s) {
+				return []interface{}{true}
+			}
+		}
+		return []interface{}{false}
+	}
+	rights := n.right.eval(obj, now)
+	for _, l := range lefts {
+		for _, r := range rights {
+			if compareRuleValues(n.op, l, r) {
+				return []interface{}{true}
+			}
+		}
+	}
+	return []interface{}{false}
+}
+
+func (n ruleCall) eval(obj map[string]interface{}, now time.Time) []interface{} {
+	var args [][]interface{}
+	for _, arg := range n.args {
+		args = append(args, arg.eval(obj, now))
+	}
+	
+	switch n.name {
+	case "len":
+		var lengths []interface{}
+		for _, v := range args[0] {
+			switch v := v.(type) {
+			case []interface{}:
+				lengths = append(lengths, int64(len(v)))
+			case map[string]interface{}:
+				lengths = append(lengths, int64(len(v)))
+			case string:
+				lengths = append(lengths, int64(len(v)))
+			}
+		}
+		if len(lengths) == 0 {
+			return []interface{}{int64(0)}
+		}
+		return lengths
+	case "count":
+		return []interface{}{int64(len(args[0]))}
+	case "sum":
+		total := 0.0
+		for _, v := range args[0] {
+			if f, ok := ruleNumber(v); ok {
+				total += f
+			}
+		}
+		return []interface{}{total}
+	case "exists":
+		return []interface{}{len(args[0]) > 0}
+	case "default":
+		if len(args[0]) > 0 {
+			return args[0]
+		}
+		return args[1]
+	case "quantity":
+		var values []interface{}
+		for _, v := range args[0] {
+			if q, err := resource.ParseQuantity(fmt.Sprint(v)); err == nil {
+				values = append(values, q.AsApproximateFloat64())
+			}
+		}
+		return values
+	case "age":
+		meta, _ := obj["metadata"].(map[string]interface{})
+		created, _ := meta["creationTimestamp"].(string)
+		t, err := time.Parse(time.RFC3339, created)
+		if err != nil {
+			return nil
+		}
+		return []interface{}{now.Sub(t).Seconds()}
+	}
+	return nil
+}
+
+// ruleFunctionArity is the number of arguments each rule function takes
+var ruleFunctionArity = map[string]int{
+	"len": 1, "count": 1, "sum": 1, "exists": 1, "default": 2, "quantity": 1, "age": 0,
+}
+
+// anyTruthy reports whether any value is truthy (non-empty, non-zero, not false)
+func anyTruthy(values []interface{}) bool {
+	for _, v := range values {
+		switch v := v.(type) {
+		case nil:
+		case bool:
+			if v {
+				return true
+			}
+		case string:
+			if v != "" {
+				return true
+			}
+		case []interface{}:
+			if len(v) > 0 {
+				return true
+			}
+		case map[string]interface{}:
+			if len(v) > 0 {
+				return true
+			}
+		default:
+			if f, ok := ruleNumber(v); !ok || f != 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ruleNumber converts numeric values from unstructured objects to float64
+func ruleNumber(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// compareRuleValues applies a comparison operator to two values
+func compareRuleValues(op string, l, r interface{}) bool {
+	if lf, ok := ruleNumber(l); ok {
+		if rf, ok := ruleNumber(r); ok {
+			switch op {
+			case "==":
+				return lf == rf
+			case "!=":
+				return lf != rf
+			case ">":
+				return lf > rf
+			case ">=":
+				return lf >= rf
+			case "<":
+				return lf < rf
+			case "<=":
+				return lf <= rf
+			}
+		}
+	}
+	
+	ls, rs := fmt.Sprint(l), fmt.Sprint(r)
+	if l == nil || r == nil {
+		ls, rs = fmt.Sprint(l == nil), fmt.Sprint(r == nil)
+		if op != "==" && op != "!=" {
+			return false
+		}
+	}
+	switch op {
+	case "==":
+		return ls == rs
+	case "!=":
+		return ls != rs
+	case ">":
+		return ls > rs
+	case ">=":
+		return ls >= rs
+	case "<":
+		return ls < rs
+	case "<=":
+		return ls <= rs
+	}
+	return false
+}
+
+// ruleToken is a lexical token of a rule expression
+type ruleToken struct {
+	kind string // "ident", "number", "string", "op" or "eof"
+	text string
+	pos  int
+}
+
+// tokenizeRuleExpr splits a rule expression into tokens
+func tokenizeRuleExpr(src string) ([]ruleToken, error) {
+	var tokens []ruleToken
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(src[i+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated string at %d", i)
+			}
+			tokens = append(tokens, ruleToken{"string", src[i+1 : i+1+end], i})
+			i += end + 2
+		case c >= '0' && c <= '9':
+			start := i
+			for i < len(src) && (src[i] >= '0' && src[i] <= '9' || src[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, ruleToken{"number", src[start:i], start})
+		case c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+			start := i
+			for i < len(src) && (src[i] == '_' || src[i] >= 'a' && src[i] <= 'z' || src[i] >= 'A' && src[i] <= 'Z' || src[i] >= '0' && src[i] <= '9') {
+				i++
+			}
+			tokens = append(tokens, ruleToken{"ident", src[start:i], start})
+		default:
+			op := ""
+			for _, candidate := range []string{"==", "!=", ">=", "<=", "=~", "&&", "||", ">", "<", "!", "(", ")", "[", "]", ".", ",", "*"} {
+				if strings.HasPrefix(src[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected %q at %d", c, i)
+			}
+			tokens = append(tokens, ruleToken{"op", op, i})
+			i += len(op)
+		}
+	}
+	return append(tokens, ruleToken{"eof", "", len(src)}), nil
+}
+
+// ruleParser is a recursive-descent parser for rule expressions
+type ruleParser struct {
+	tokens []ruleToken
+	pos    int
+}
+
+// parseRuleExpr parses a rule expression
+func parseRuleExpr(src string) (ruleNode, error) {
+	if strings.TrimSpace(src) == "" {
+		return nil, fmt.Errorf("expression is empty")
+	}
+	tokens, err := tokenizeRuleExpr(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &ruleParser{tokens: tokens}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != "eof" {
+		return nil, fmt.Errorf("unexpected %q at %d", tok.text, tok.pos)
+	}
+	return node, nil
+}
+
+func (p *ruleParser) peek() ruleToken {
+	return p.tokens[p.pos]
+}
+
+func (p *ruleParser) next() ruleToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != "eof" {
+		p.pos++
+	}
+	return tok
+}
+
+// accept consumes the next token if it is the operator op
+func (p *ruleParser) accept(op string) bool {
+	if tok := p.peek(); tok.kind == "op" && tok.text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *ruleParser) expect(op string) error {
+	if !p.accept(op) {
+		tok := p.peek()
+		return fmt.Errorf("expected %q at %d, got %q", op, tok.pos, tok.text)
+	}
+	return nil
+}
+
+func (p *ruleParser) parseOr() (ruleNode, error) {
+	left, err := p.parseAnd()
+	for err == nil && p.accept("||") {
+		var right ruleNode
+		right, err = p.parseAnd()
+		left = ruleLogical{and: false, left: left, right: right}
+	}
+	return left, err
+}
+
+func (p *ruleParser) parseAnd() (ruleNode, error) {
+	left, err := p.parseUnary()
+	for err == nil && p.accept("&&") {
+		var right ruleNode
+		right, err = p.parseUnary()
+		left = ruleLogical{and: true, left: left, right: right}
+	}
+	return left, err
+}
+
+func (p *ruleParser) parseUnary() (ruleNode, error) {
+	if p.accept("!") {
+		x, err := p.parseUnary()
+		return ruleNot{x: x}, err
+	}
+	return p.parseCompare()
+}
+
+func (p *ruleParser) parseCompare() (ruleNode, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	tok := p.peek()
+	if tok.kind != "op" {
+		return left, nil
+	}
+	switch tok.text {
+	case "==", "!=", ">", ">=", "<", "<=":
+		p.next()
+		right, err := p.parseOperand()
+		return ruleCompare{op: tok.text, left: left, right: right}, err
+	case "=~":
+		p.next()
+		patternTok := p.next()
+		if patternTok.kind != "string" {
+			return nil, fmt.Errorf("=~ needs a quoted regular expression at %d", patternTok.pos)
+		}
+		pattern, err := regexp.Compile(patternTok.text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %v", patternTok.text, err)
+		}
+		return ruleCompare{op: "=~", left: left, pattern: pattern}, nil
+	}
+	return left, nil
+}
+
+func (p *ruleParser) parseOperand() (ruleNode, error) {
+	tok := p.next()
+	switch tok.kind {
+	case "eof":
+		return nil, fmt.Errorf("unexpected end of expression")
+	case "number":
+		f, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at %d", tok.text, tok.pos)
+		}
+		return ruleLiteral{value: f}, nil
+	case "string":
+		return ruleLiteral{value: tok.text}, nil
+	case "op":
+		if tok.text == "(" {
+			node, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			return node, p.expect(")")
+		}
+	case "ident":
+		switch tok.text {
+		case "true":
+			return ruleLiteral{value: true}, nil
+		case "false":
+			return ruleLiteral{value: false}, nil
+		case "null":
+			return ruleLiteral{value: nil}, nil
+		}
+		if p.accept("(") {
+			return p.parseCall(tok)
+		}
+		return p.parsePath(tok)
+	}
+	return nil, fmt.Errorf("unexpected %q at %d", tok.text, tok.pos)
+}
+
+func (p *ruleParser) parseCall(name ruleToken) (ruleNode, error) {
+	arity, ok := ruleFunctionArity[name.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q at %d", name.text, name.pos)
+	}
+	call := ruleCall{name: name.text}
+	if !p.accept(")") {
+		for {
+			arg, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			call.args = append(call.args, arg)
+			if p.accept(")") {
+				break
+			}
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(call.args) != arity {
+		return nil, fmt.Errorf("%s() takes %d argument(s), got %d", name.text, arity, len(call.args))
+	}
+	return call, nil
+}
+
+func (p *ruleParser) parsePath(first ruleToken) (ruleNode, error) {
+	path := rulePath{steps: []rulePathStep{{key: first.text}}}
+	for {
+		if p.accept(".") {
+			tok := p.next()
+			if tok.kind != "ident" {
+				return nil, fmt.Errorf("expected field name at %d", tok.pos)
+			}
+			path.steps = append(path.steps, rulePathStep{key: tok.text})
+			continue
+		}
+		if p.accept("[") {
+			tok := p.peek()
+			switch {
+			case tok.kind == "op" && tok.text == "*":
+				p.next()
+				path.steps = append(path.steps, rulePathStep{wildcard: true})
+			case tok.kind == "number":
+				p.next()
+				index, err := strconv.Atoi(tok.text)
+				if err != nil {
+					return nil, fmt.Errorf("invalid index %q at %d", tok.text, tok.pos)
+				}
+				path.steps = append(path.steps, rulePathStep{index: index, isIndex: true})
+			case tok.kind == "string" && p.tokens[p.pos+1].text == "]":
+				p.next()
+				path.steps = append(path.steps, rulePathStep{key: tok.text})
+			default:
+				filter, err := p.parseOr()
+				if err != nil {
+					return nil, fmt.Errorf("expected index, key, * or filter expression: %v", err)
+				}
+				path.steps = append(path.steps, rulePathStep{filter: filter})
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		return path, nil
+	}
+}
+
+// Utility functions
+func truncateString(s string, maxLen int) string {
+	if len(s) <= maxLen {
+		return s
+	}
+	return s[:maxLen-3] + "..."
+}
+
+func accessModesToStrings(modes []corev1.PersistentVolumeAccessMode) []string {
+	var result []string
+	for _, mode := range modes {
+		result = append(result, string(mode))
+	}
+	return result
+}
+
+func stringPtrValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// humanAge formats duration into human-readable age string
+func humanAge(d time.Duration) string {
+	s := int(d.Seconds())
+	m := s / 60
+	h := m / 60
+	days := h / 24
+
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd", days)
+	case h > 0:
+		return fmt.Sprintf("%dh", h)
+	case m > 0:
+		return fmt.Sprintf("%dm", m)
+	default:
+		return fmt.Sprintf("%ds", s)
+	}
+}
+
+// formatDuration formats the time between start and end (e.g. a Job's run time)
+func formatDuration(start, end *metav1.Time) string {
+	if start == nil {
+		return "not started"
+	}
+	if end == nil {
+		return "running"
+	}
+	return end.Sub(start.Time).String()
+}
+
+// cronSchedule is a parsed standard 5-field cron expression as used by CronJobs
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64 // Bit sets of allowed values
+	domStar, dowStar              bool   // Whether day-of-month / day-of-week were "*"
+}
+
+// cronMacros maps the predefined schedules accepted by the CronJob controller
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var cronMonthNames = map[string]int{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+	"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+var cronDayNames = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+// parseCronSchedule parses a cron expression; a CRON_TZ=/TZ= prefix overrides the time zone
+func parseCronSchedule(spec string, loc *time.Location) (cronSchedule, *time.Location, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "CRON_TZ=") || strings.HasPrefix(spec, "TZ=") {
+		parts := strings.SplitN(spec, " ", 2)
+		if len(parts) != 2 {
+			return cronSchedule{}, nil, fmt.Errorf("missing schedule after %s", parts[0])
+		}
+		tz := parts[0][strings.Index(parts[0], "=")+1:]
+		zone, err := time.LoadLocation(tz)
+		if err != nil {
+			return cronSchedule{}, nil, fmt.Errorf("unknown time zone %q", tz)
+		}
+		loc = zone
+		spec = strings.TrimSpace(parts[1])
+	}
+	if macro, ok := cronMacros[spec]; ok {
+		spec = macro
+	}
+	
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return cronSchedule{}, nil, fmt.Errorf("expected 5 fields in schedule %q, got %d", spec, len(fields))
+	}
+	
+	var sched cronSchedule
+	var err error
+	if sched.minute, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return cronSchedule{}, nil, err
+	}
+	if sched.hour, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return cronSchedule{}, nil, err
+	}
+	if sched.dom, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return cronSchedule{}, nil, err
+	}
+	if sched.month, err = parseCronField(fields[3], 1, 12, cronMonthNames); err != nil {
+		return cronSchedule{}, nil, err
+	}
+	if sched.dow, err = parseCronField(fields[4], 0, 7, cronDayNames); err != nil {
+		return cronSchedule{}, nil, err
+	}
+	// Sunday may be written as 0 or 7
+	if sched.dow&(1<<7) != 0 {
+		sched.dow |= 1
+	}
+	sched.domStar = fields[2] == "*" || fields[2] == "?"
+	sched.dowStar = fields[4] == "*" || fields[4] == "?"
+	
+	return sched, loc, nil
+}
+
+// parseCronField parses one comma-separated cron field into a bit set
+func parseCronField(field string, low, high int, names map[string]int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if idx := strings.Index(part, "/"); idx >= 0 {
+			n, err := strconv.Atoi(part[idx+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step in %q", part)
+			}
+			step = n
+			part = part[:idx]
+		}
+		
+		start, end := low, high
+		if part != "*" && part != "?" {
+			bounds := strings.SplitN(part, "-", 2)
+			var err error
+			if start, err = parseCronValue(bounds[0], names); err != nil {
+				return 0, err
+			}
+			end = start
+			if len(bounds) == 2 {
+				if end, err = parseCronValue(bounds[1], names); err != nil {
+					return 0, err
+				}
+			} else if step > 1 {
+				end = high
+			}
+		}
+		if start < low || end > high || start > end {
+			return 0, fmt.Errorf("value %q out of range %d-%d", part, low, high)
+		}
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func parseCronValue(value string, names map[string]int) (int, error) {
+	if n, ok := names[strings.ToLower(value)]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	return n, nil
+}
+
+// dayMatches applies cron's rule that restricted day-of-month and day-of-week fields are OR'ed
+func (c cronSchedule) dayMatches(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// next returns the first activation strictly after t, or the zero time if none within five years
+func (c cronSchedule) next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Year() + 5
+	
+	for t.Year() <= limit {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = cronAdvance(t, time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc))
+			continue
+		}
+		if !c.dayMatches(t) {
+			t = cronAdvance(t, time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc))
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = cronAdvance(t, time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc))
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// cronAdvance returns next, moved past t when a DST gap made time.Date map it back to t or earlier
+// (02:00 on a spring-forward day can come back as 01:00 standard time)
+func cronAdvance(t, next time.Time) time.Time {
+	for !next.After(t) {
+		next = next.Add(time.Hour)
+	}
+	return next
+}
+
+// nextCronRun computes the next scheduled run of a CronJob schedule in the given time zone
+func nextCronRun(schedule, timeZone string, now time.Time) (time.Time, error) {
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unknown time zone %q", timeZone)
+	}
+	sched, loc, err := parseCronSchedule(schedule, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return sched.next(now.In(loc)), nil
+}
+
+// CLIOptions are the command-line flags of the interactive UI
+type CLIOptions struct {
+	Kubeconfig string
+	Context    string
+	Namespace  string // "all" for all namespaces
+	Resource   string // Kind to open, by plural, singular or short name
+	Selector   string // Label selector for Resource
+	ReadOnly   bool
+	Refresh    string // Auto-refresh interval, "0" turns auto-refresh off
+	Replay     string // Snapshot file browsed instead of a cluster
+	Dump       string // must-gather or cluster-info dump browsed instead of a cluster
+}
+
+// cliUsage is printed for -h and flag errors
+const cliUsage = `Usage: k8sgo [flags]
+       k8sgo get <kind> [-n ns] [-A] [-o table|wide|json|yaml]
+       k8sgo report [--all-contexts] [-o markdown|json|junit] [--ignore FILE]
+       k8sgo exporter [--listen :9191] [--interval 60s] [--all-contexts]
+       k8sgo completion bash|zsh|fish
+
+Flags:
+      --kubeconfig PATH   kubeconfig file (default $KUBECONFIG or ~/.kube/config)
+  -c, --context NAME      open this kubeconfig context
+  -n, --namespace NAME    open this namespace ("all" for all namespaces)
+  -r, --resource KIND     open the list of this kind, e.g. pods, deploy, svc
+  -l, --selector EXPR     label selector for --resource, e.g. app=checkout
+      --readonly          disable actions that change the cluster (trigger, suspend, exec)
+      --refresh DURATION  auto-refresh interval, e.g. 10s; 0 turns auto-refresh off
+      --replay FILE       browse a snapshot recorded with :snapshot or :record instead of a cluster
+      --dump PATH         browse a must-gather or 'kubectl cluster-info dump' directory or tar(.gz) archive
+
+Example: k8sgo -c prod -n payments -r deploy
+`
+
+// parseFlags parses the UI flags; the long and short form of a flag share one variable
+func parseFlags(args []string) (CLIOptions, error) {
+	var opts CLIOptions
+	fs := flag.NewFlagSet("k8sgo", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.StringVar(&opts.Kubeconfig, "kubeconfig", "", "")
+	for _, name := range []string{"context", "c"} {
+		fs.StringVar(&opts.Context, name, "", "")
+	}
+	for _, name := range []string{"namespace", "n"} {
+		fs.StringVar(&opts.Namespace, name, "", "")
+	}
+	for _, name := range []string{"resource", "r"} {
+		fs.StringVar(&opts.Resource, name, "", "")
+	}
+	for _, name := range []string{"selector", "l"} {
+		fs.StringVar(&opts.Selector, name, "", "")
+	}
+	fs.BoolVar(&opts.ReadOnly, "readonly", false, "")
+	fs.StringVar(&opts.Refresh, "refresh", "", "")
+	fs.StringVar(&opts.Replay, "replay", "", "")
+	fs.StringVar(&opts.Dump, "dump", "", "")
+	if err := fs.Parse(args); err != nil {
+		return opts, err
+	}
+	if fs.NArg() > 0 {
+		return opts, fmt.Errorf("unexpected argument %q", fs.Arg(0))
+	}
+	return opts, nil
+}
+
+// validate checks the flags against the kubeconfig and the known kinds
+func (o CLIOptions) validate() error {
+	var problems []string
+	if o.Replay != "" && o.Dump != "" {
+		problems = append(problems, "--replay and --dump cannot be combined")
+	}
+	if o.Replay != "" && (o.Context != "" || o.Kubeconfig != "") {
+		problems = append(problems, "--replay: a snapshot holds one context and needs no kubeconfig; drop --context and --kubeconfig")
+	} else if o.Dump != "" && (o.Context != "" || o.Kubeconfig != "") {
+		problems = append(problems, "--dump: a dump holds one cluster and needs no kubeconfig; drop --context and --kubeconfig")
+	} else if o.Context != "" {
+		contexts := kubeconfigContexts()
+		sort.Strings(contexts)
+		if len(contexts) == 0 {
+			problems = append(problems, fmt.Sprintf("--context: context %q not found: the kubeconfig has no contexts", o.Context))
+		} else if !containsString(contexts, o.Context) {
+			problems = append(problems, fmt.Sprintf("--context: context %q not found in kubeconfig (available: %s)", o.Context, strings.Join(contexts, ", ")))
+		}
+	}
+	if o.Resource != "" {
+		rt, ok := staticKindAliases()[strings.ToLower(o.Resource)]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("--resource: unknown resource type %q", o.Resource))
+		} else if rt.GetResourceInfo().Scope == ClusterScoped && o.Namespace != "" {
+			problems = append(problems, fmt.Sprintf("--namespace: %s are cluster-scoped and take no namespace", rt.GetResourceInfo().Name))
+		}
+	}
+	if o.Selector != "" {
+		if o.Resource == "" {
+			problems = append(problems, "--selector: needs --resource")
+		} else if _, err := labels.Parse(o.Selector); err != nil {
+			problems = append(problems, fmt.Sprintf("--selector: invalid label selector: %v", err))
+		}
+	}
+	if o.Refresh != "" {
+		if d, err := time.ParseDuration(o.Refresh); o.Refresh != "0" && (err != nil || d < time.Second) {
+			problems = append(problems, fmt.Sprintf("--refresh: %q is not a duration of at least 1s (e.g. 10s) or 0", o.Refresh))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+// startCommand returns the palette command that opens the view named by the flags, or ""
+func (o CLIOptions) startCommand() string {
+	namespace := o.Namespace
+	if namespace == "" && o.Resource != "" {
+		return o.Resource
+	}
+	if o.Resource != "" {
+		return o.Resource + " " + namespace
+	}
+	if namespace != "" {
+		return "ns " + namespace
+	}
+	return ""
+}
+
+// runStartCommand opens the view requested on the command line once the start context is connected
+func (m Model) runStartCommand() (tea.Model, tea.Cmd) {
+	line := m.startCommand
+	m.startCommand = ""
+	cmd, err := m.parseCommand(line)
+	if err != nil {
+		m.errorMessage = fmt.Sprintf("Cannot open %s: %v", line, err)
+		return m, nil
+	}
+	if cmd.verb == "kind" && m.startSelector != "" {
+		m.selectors[cmd.kind] = ResourceSelector{Label: m.startSelector}
+	}
+	return m.runCommand(cmd)
+}
+
+// completionScripts are printed by 'k8sgo completion <shell>'
+var completionScripts = map[string]string{
+	"bash": `# k8sgo bash completion; load with: source <(k8sgo completion bash)
+_k8sgo() {
+    local cur prev context i
+    cur="${COMP_WORDS[COMP_CWORD]}"
+    prev="${COMP_WORDS[COMP_CWORD-1]}"
+    for ((i = 1; i < COMP_CWORD; i++)); do
+        case "${COMP_WORDS[i]}" in
+            -c|--context) context="${COMP_WORDS[i+1]}" ;;
+        esac
+    done
+    case "$prev" in
+        -c|--context) COMPREPLY=($(compgen -W "$(k8sgo __complete contexts 2>/dev/null)" -- "$cur")); return ;;
+        -n|--namespace) COMPREPLY=($(compgen -W "all $(k8sgo __complete namespaces $context 2>/dev/null)" -- "$cur")); return ;;
+        -r|--resource) COMPREPLY=($(compgen -W "$(k8sgo __complete kinds 2>/dev/null)" -- "$cur")); return ;;
+        --kubeconfig|--replay|--dump) COMPREPLY=($(compgen -f -- "$cur")); return ;;
+        -o|--output|--format)
+            if [[ "${COMP_WORDS[1]}" == report ]]; then
+                COMPREPLY=($(compgen -W "markdown json junit" -- "$cur"))
+            else
+                COMPREPLY=($(compgen -W "table wide json yaml" -- "$cur"))
+            fi
+            return ;;
+        --min-severity) COMPREPLY=($(compgen -W "warning error" -- "$cur")); return ;;
+        --fail-on) COMPREPLY=($(compgen -W "error warning never" -- "$cur")); return ;;
+        --ignore) COMPREPLY=($(compgen -f -- "$cur")); return ;;
+        --kinds) COMPREPLY=($(compgen -W "$(k8sgo __complete kinds 2>/dev/null)" -- "$cur")); return ;;
+        -l|--selector|--field-selector|--refresh|--listen|--interval) return ;;
+        get) COMPREPLY=($(compgen -W "$(k8sgo __complete kinds 2>/dev/null)" -- "$cur")); return ;;
+        completion) COMPREPLY=($(compgen -W "bash zsh fish" -- "$cur")); return ;;
+    esac
+    if [[ "${COMP_WORDS[1]}" == get ]]; then
+        COMPREPLY=($(compgen -W "--namespace --all-namespaces --selector --field-selector --output --context --kubeconfig" -- "$cur"))
+        return
+    fi
+    if [[ "${COMP_WORDS[1]}" == report ]]; then
+        COMPREPLY=($(compgen -W "--context --all-contexts --namespace --kinds --min-severity --fail-on --ignore --format --kubeconfig" -- "$cur"))
+        return
+    fi
+    if [[ "${COMP_WORDS[1]}" == exporter ]]; then
+        COMPREPLY=($(compgen -W "--listen --interval --once --context --all-contexts --namespace --kinds --kubeconfig" -- "$cur"))
+        return
+    fi
+    COMPREPLY=($(compgen -W "--kubeconfig --context --namespace --resource --selector --readonly --refresh --replay --dump get report exporter completion" -- "$cur"))
+}
+complete -F _k8sgo k8sgo
+`,
+	"zsh": `#compdef k8sgo
+# k8sgo zsh completion; load with: source <(k8sgo completion zsh)
+_k8sgo() {
+    local state
+    typeset -A opt_args
+    _arguments \
+        '--kubeconfig[kubeconfig file]:file:_files' \
+        '(-c --context)'{-c,--context}'[kubeconfig context]:context:->contexts' \
+        '(-n --namespace)'{-n,--namespace}'[namespace]:namespace:->namespaces' \
+        '(-r --resource)'{-r,--resource}'[resource kind]:kind:->kinds' \
+        '(-l --selector)'{-l,--selector}'[label selector]:selector:' \
+        '--readonly[disable actions that change the cluster]' \
+        '--refresh[auto-refresh interval, 0 to disable]:interval:' \
+        '--replay[browse a recorded snapshot]:file:_files' \
+        '--dump[browse a must-gather or cluster-info dump]:path:_files' \
+        '(-A --all-namespaces)'{-A,--all-namespaces}'[list across all namespaces (get)]' \
+        '(-o --output)'{-o,--output}'[output format (get)]:format:(table wide json yaml)' \
+        '--field-selector[field selector (get)]:selector:' \
+        '--all-contexts[scan every context (report, exporter)]' \
+        '--kinds[kinds to scan (report, exporter)]:kinds:' \
+        '--min-severity[lowest severity reported (report)]:severity:(warning error)' \
+        '--fail-on[severity that fails the run (report)]:severity:(error warning never)' \
+        '--ignore[ignore file (report)]:file:_files' \
+        '--format[report format (report)]:format:(markdown json junit)' \
+        '--listen[address to serve metrics on (exporter)]:address:' \
+        '--interval[time between scans (exporter)]:interval:' \
+        '--once[scan once and print the metrics (exporter)]' \
+        '1::command:(get report exporter completion)' \
+        '2::argument:->argument'
+    case $state in
+        argument)
+            case $words[2] in
+                get) compadd -- ${(f)"$(k8sgo __complete kinds 2>/dev/null)"} ;;
+                completion) compadd -- bash zsh fish ;;
+            esac ;;
+        contexts) compadd -- ${(f)"$(k8sgo __complete contexts 2>/dev/null)"} ;;
+        namespaces) compadd -- all ${(f)"$(k8sgo __complete namespaces ${opt_args[--context]:-${opt_args[-c]}} 2>/dev/null)"} ;;
+        kinds) compadd -- ${(f)"$(k8sgo __complete kinds 2>/dev/null)"} ;;
+    esac
+}
+compdef _k8sgo k8sgo
+`,
+	"fish": `# k8sgo fish completion; load with: k8sgo completion fish | source
+function __k8sgo_context
+    set -l tokens (commandline -opc)
+    for i in (seq (count $tokens))
+        if contains -- $tokens[$i] -c --context
+            echo $tokens[(math $i + 1)]
+        end
+    end
+end
+complete -c k8sgo -f
+complete -c k8sgo -n __fish_use_subcommand -a get -d 'List one kind with health analysis'
+complete -c k8sgo -n __fish_use_subcommand -a report -d 'Report errors and warnings across contexts'
+complete -c k8sgo -n __fish_use_subcommand -a exporter -d 'Serve health findings as Prometheus metrics'
+complete -c k8sgo -n __fish_use_subcommand -a completion -d 'Print a shell completion script'
+complete -c k8sgo -n '__fish_seen_subcommand_from get' -a '(k8sgo __complete kinds 2>/dev/null)'
+complete -c k8sgo -n '__fish_seen_subcommand_from get' -s A -l all-namespaces -d 'List across all namespaces'
+complete -c k8sgo -n '__fish_seen_subcommand_from get' -s o -l output -x -a 'table wide json yaml' -d 'Output format'
+complete -c k8sgo -n '__fish_seen_subcommand_from get' -l field-selector -x -d 'Field selector'
+complete -c k8sgo -n '__fish_seen_subcommand_from report exporter' -l all-contexts -d 'Scan every context'
+complete -c k8sgo -n '__fish_seen_subcommand_from report exporter' -l kinds -x -d 'Comma-separated kinds'
+complete -c k8sgo -n '__fish_seen_subcommand_from report' -l min-severity -x -a 'warning error' -d 'Lowest severity reported'
+complete -c k8sgo -n '__fish_seen_subcommand_from report' -l fail-on -x -a 'error warning never' -d 'Severity that fails the run'
+complete -c k8sgo -n '__fish_seen_subcommand_from report' -l ignore -r -F -d 'Ignore file'
+complete -c k8sgo -n '__fish_seen_subcommand_from report' -s o -l format -x -a 'markdown json junit' -d 'Report format'
+complete -c k8sgo -n '__fish_seen_subcommand_from exporter' -l listen -x -d 'Address to serve metrics on'
+complete -c k8sgo -n '__fish_seen_subcommand_from exporter' -l interval -x -d 'Time between scans'
+complete -c k8sgo -n '__fish_seen_subcommand_from exporter' -l once -d 'Scan once and print the metrics'
+complete -c k8sgo -n '__fish_seen_subcommand_from completion' -a 'bash zsh fish'
+complete -c k8sgo -l kubeconfig -r -F -d 'Kubeconfig file'
+complete -c k8sgo -s c -l context -x -a '(k8sgo __complete contexts 2>/dev/null)' -d 'Kubeconfig context'
+complete -c k8sgo -s n -l namespace -x -a 'all (k8sgo __complete namespaces (__k8sgo_context) 2>/dev/null)' -d 'Namespace'
+complete -c k8sgo -s r -l resource -x -a '(k8sgo __complete kinds 2>/dev/null)' -d 'Resource kind'
+complete -c k8sgo -s l -l selector -x -d 'Label selector'
+complete -c k8sgo -l readonly -d 'Disable actions that change the cluster'
+complete -c k8sgo -l refresh -x -d 'Auto-refresh interval, 0 to disable'
+complete -c k8sgo -l replay -r -F -d 'Browse a recorded snapshot'
+complete -c k8sgo -l dump -r -F -d 'Browse a must-gather or cluster-info dump'
+`,
+}
+
+// runCompletion prints the completion script for a shell
+func runCompletion(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: k8sgo completion bash|zsh|fish")
+	}
+	script, ok := completionScripts[args[0]]
+	if !ok {
+		return fmt.Errorf("unsupported shell %q (use bash, zsh or fish)", args[0])
+	}
+	fmt.Print(script)
+	return nil
+}
+
+// runComplete prints the candidates the completion scripts offer: contexts, namespaces [context] or kinds
+func runComplete(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: k8sgo __complete contexts|namespaces [context]|kinds")
+	}
+	var candidates []string
+	switch args[0] {
+	case "contexts":
+		candidates = kubeconfigContexts()
+	case "kinds":
+		for alias := range staticKindAliases() {
+			candidates = append(candidates, alias)
+		}
+	case "namespaces":
+		contextName := ""
+		if len(args) > 1 {
+			contextName = args[1]
+		}
+		config, err := kubernetesConfigFor(contextName)
+		if err != nil {
+			return err
+		}
+		config.Timeout = 3 * time.Second // Keep the shell responsive when the cluster is unreachable
+		clientset, err := kubernetes.NewForConfig(config)
+		if err != nil {
+			return err
+		}
+		nsList, err := clientset.CoreV1().Namespaces().List(context.Background(), metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		for _, ns := range nsList.Items {
+			candidates = append(candidates, ns.Name)
+		}
+	default:
+		return fmt.Errorf("unknown completion %q", args[0])
+	}
+	sort.Strings(candidates)
+	fmt.Println(strings.Join(candidates, "\n"))
+	return nil
+}
+
+// subcommands run instead of the interactive UI when named as the first argument
+var subcommands = map[string]func(args []string) error{
+	"get":        runGet,
+	"report":      runReport,
+	"exporter":    runExporter,
+	"completion": runCompletion,
+	"__complete": runComplete,
+}
+
+// exitStatus is returned by a subcommand to exit with a status without printing an error
+type exitStatus int
+
+func (e exitStatus) Error() string {
+	return fmt.Sprintf("exit status %d", int(e))
+}
+
+// parseInterspersed parses flags that may come before or after the positional arguments
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// headlessModel connects to a context ("" for the current one) for use without the UI
+func headlessModel(contextName string) (Model, error) {
+	rules, err := loadRuleSet(rulesFilePath())
+	if err != nil {
+		return Model{}, fmt.Errorf("invalid health rules: %v", err)
+	}
+	appConfig, err := loadConfig(configFilePath())
+	if err != nil {
+		return Model{}, fmt.Errorf("invalid configuration: %v", err)
+	}
+	if contextName == "" {
+		if kubeconfig, err := clientcmd.NewDefaultClientConfigLoadingRules().Load(); err == nil {
+			contextName = kubeconfig.CurrentContext
+		}
+	}
+	
+	clients := connectContext(contextName)
+	if clients.err != nil {
+		return Model{}, clients.err
+	}
+	return Model{
+		clientset:           clients.clientset,
+		ctx:                 context.Background(),
+		openshiftAppsClient: clients.openshiftAppsClient,
+		routeClient:         clients.routeClient,
+		projectClient:       clients.projectClient,
+		isOpenShift:         clients.isOpenShift,
+		selectedKubeContext: contextName,
+		selectors:           make(map[ResourceType]ResourceSelector),
+		sortOrders:          make(map[ResourceType]tableSort),
+		metricHistory:       make(map[string]*MetricSeries),
+		permissionCache:     make(map[string]*permissionSet),
+		rules:               rules,
+		config:              appConfig,
+	}, nil
+}
+
+// defaultNamespace returns the namespace used when none is given: the configured one, else the context's, else "default"
+func (m Model) defaultNamespace() string {
+	if namespace := m.settings().DefaultNamespace; namespace != "" {
+		return configNamespace(namespace)
+	}
+	if config, err := clientcmd.NewDefaultClientConfigLoadingRules().Load(); err == nil {
+		if kubeContext, ok := config.Contexts[m.selectedKubeContext]; ok && kubeContext.Namespace != "" {
+			return kubeContext.Namespace
+		}
+	}
+	return metav1.NamespaceDefault
+}
+
+// resourceOutput is the JSON and YAML form of a K8sResource
+type resourceOutput struct {
+	Kind      string            `json:"kind"`
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace,omitempty"`
+	Status    string            `json:"status"`
+	Age       string            `json:"age"`
+	Details   map[string]string `json:"details,omitempty"`
+	Errors    []string          `json:"errors,omitempty"`
+	Warnings  []string          `json:"warnings,omitempty"`
+	Findings  []RuleFinding     `json:"findings,omitempty"`
+}
+
+// resourceListOutput is the document printed by 'k8sgo get -o json|yaml'
+type resourceListOutput struct {
+	Context   string           `json:"context"`
+	Namespace string           `json:"namespace,omitempty"` // Empty for all namespaces and cluster-scoped kinds
+	Kind      string           `json:"kind"`
+	Items     []resourceOutput `json:"items"`
+	Summary   struct {
+		Total    int `json:"total"`
+		Errors   int `json:"errors"`   // Resources with at least one error
+		Warnings int `json:"warnings"` // Resources with warnings but no errors
+	} `json:"summary"`
+}
+
+// newResourceOutput converts a resource for machine-readable output
+func newResourceOutput(res K8sResource) resourceOutput {
+	return resourceOutput{
+		Kind:      kindLabel(res.ResourceType),
+		Name:      res.Name,
+		Namespace: res.Namespace,
+		Status:    res.Status,
+		Age:       res.Age,
+		Details:   res.Details,
+		Errors:    res.Errors,
+		Warnings:  res.Warnings,
+		Findings:  res.Findings,
+	}
+}
+
+// issueSummary condenses the errors and warnings of a resource for the ISSUES column; wide lists them all
+func issueSummary(res K8sResource, wide bool) string {
+	var issues []string
+	for _, e := range res.Errors {
+		issues = append(issues, "error: "+e)
+	}
+	for _, w := range res.Warnings {
+		issues = append(issues, "warning: "+w)
+	}
+	switch {
+	case len(issues) == 0:
+		return "-"
+	case wide || len(issues) == 1:
+		return strings.Join(issues, "; ")
+	}
+	return fmt.Sprintf("%s (+%d more)", issues[0], len(issues)-1)
+}
+
+// writeResourceTable prints resources as a plain-text table with an ISSUES column
+func (m Model) writeResourceTable(w io.Writer, resources []K8sResource, wide bool) {
+	columns := m.resourceColumns(m.selectedResource)
+	if wide {
+		columns = m.availableColumns(m.selectedResource)
+	}
+	columns = append(columns, TableColumn{Title: "ISSUES", Width: 1 << 16,
+		Value: func(res K8sResource) string { return issueSummary(res, wide) }})
+	columns, widths := fitColumns(columns, resources, 1<<20, "")
+	
+	row := func(values []string) {
+		cells := make([]string, len(values))
+		for i, value := range values {
+			if i < len(values)-1 {
+				value += strings.Repeat(" ", max(0, widths[i]-utf8.RuneCountInString(value)))
+			}
+			cells[i] = value
+		}
+		fmt.Fprintln(w, strings.Join(cells, "   "))
+	}
+	titles := make([]string, len(columns))
+	for i, col := range columns {
+		titles[i] = col.Title
+	}
+	row(titles)
+	for _, res := range resources {
+		values := make([]string, len(columns))
+		for i, col := range columns {
+			values[i] = col.Value(res)
+			if values[i] == "" {
+				values[i] = "-"
+			}
+		}
+		row(values)
+	}
+}
+
+// getUsage is printed for 'k8sgo get -h' and usage errors
+const getUsage = `Usage: k8sgo get <kind> [flags]
+
+Lists one kind with k8sGo's health analysis. Exits 1 when any listed resource has errors, 2 on failure.
+
+Flags:
+  -n, --namespace NAME    namespace (default: configured, then the context's, then "default")
+  -A, --all-namespaces    list across all namespaces
+  -l, --selector EXPR     label selector, e.g. app=checkout
+      --field-selector EXPR
+                          field selector, e.g. status.phase=Running
+  -o, --output FORMAT     table, wide, json or yaml (default table)
+  -c, --context NAME      kubeconfig context (default: current)
+      --kubeconfig PATH   kubeconfig file
+
+Example: k8sgo get pods -n payments -o wide
+`
+
+// runGet lists one kind without the UI: k8sgo get <kind> [-n ns] [-A] [-o table|wide|json|yaml]
+func runGet(args []string) error {
+	var namespace, labelSelector, fieldSelector, output, contextName, kubeconfig string
+	var allNamespaces bool
+	fs := flag.NewFlagSet("get", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	for _, name := range []string{"namespace", "n"} {
+		fs.StringVar(&namespace, name, "", "")
+	}
+	for _, name := range []string{"all-namespaces", "A"} {
+		fs.BoolVar(&allNamespaces, name, false, "")
+	}
+	for _, name := range []string{"selector", "l"} {
+		fs.StringVar(&labelSelector, name, "", "")
+	}
+	fs.StringVar(&fieldSelector, "field-selector", "", "")
+	for _, name := range []string{"output", "o"} {
+		fs.StringVar(&output, name, "table", "")
+	}
+	for _, name := range []string{"context", "c"} {
+		fs.StringVar(&contextName, name, "", "")
+	}
+	fs.StringVar(&kubeconfig, "kubeconfig", "", "")
+	
+	positional, err := parseInterspersed(fs, args)
+	if err == flag.ErrHelp {
+		fmt.Print(getUsage)
+		return nil
+	}
+	if err == nil && len(positional) != 1 {
+		err = fmt.Errorf("expected exactly one kind, got %d arguments", len(positional))
+	}
+	if err != nil {
+		return fmt.Errorf("%v\n\n%s", err, getUsage)
+	}
+	rt, ok := staticKindAliases()[strings.ToLower(positional[0])]
+	if !ok {
+		return fmt.Errorf("unknown resource type %q", positional[0])
+	}
+	if !hasLoader(rt) {
+		return fmt.Errorf("listing %s is not supported yet", rt.GetResourceInfo().Name)
+	}
+	if !containsString([]string{"table", "wide", "json", "yaml"}, output) {
+		return fmt.Errorf("unknown output format %q (use table, wide, json or yaml)", output)
+	}
+	if allNamespaces && namespace != "" {
+		return fmt.Errorf("--namespace and --all-namespaces are mutually exclusive")
+	}
+	if _, err := labels.Parse(labelSelector); err != nil {
+		return fmt.Errorf("invalid label selector: %v", err)
+	}
+	if _, err := fields.ParseSelector(fieldSelector); err != nil {
+		return fmt.Errorf("invalid field selector: %v", err)
+	}
+	if kubeconfig != "" {
+		os.Setenv("KUBECONFIG", kubeconfig)
+	}
+	
+	m, err := headlessModel(contextName)
+	if err != nil {
+		return err
+	}
+	m.selectedResource = rt
+	m.selectedScope = rt.GetResourceInfo().Scope
+	if m.selectedScope == NamespaceScoped {
+		switch {
+		case allNamespaces:
+			m.selectedNamespace = metav1.NamespaceAll
+		case namespace != "":
+			m.selectedNamespace = namespace
+		default:
+			m.selectedNamespace = m.defaultNamespace()
+		}
+	}
+	if labelSelector != "" || fieldSelector != "" {
+		m.selectors[rt] = ResourceSelector{Label: labelSelector, Field: fieldSelector}
+	}
+	
+	resources, err := m.fetchResources(rt)
+	if err != nil {
+		return fmt.Errorf("failed to list %s: %v", strings.ToLower(rt.String()), err)
+	}
+	// Usage columns are best effort, as in the UI
+	switch rt {
+	case NodesResource:
+		m.attachNodeMetrics(resources)
+	case PodsResource:
+		m.attachPodMetrics(resources)
+	}
+	
+	list := resourceListOutput{Context: m.selectedKubeContext, Namespace: m.selectedNamespace, Kind: kindLabel(rt), Items: []resourceOutput{}}
+	for _, res := range resources {
+		list.Items = append(list.Items, newResourceOutput(res))
+		if len(res.Errors) > 0 {
+			list.Summary.Errors++
+		} else if len(res.Warnings) > 0 {
+			list.Summary.Warnings++
+		}
+	}
+	list.Summary.Total = len(resources)
+	
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(list, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	case "yaml":
+		data, err := yaml.Marshal(list)
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(data))
+	default:
+		if len(resources) == 0 {
+			fmt.Fprintf(os.Stderr, "No %s found in %s\n", strings.ToLower(rt.String()), m.scopeLabel())
+		} else {
+			m.writeResourceTable(os.Stdout, resources, output == "wide")
+		}
+	}
+	
+	if list.Summary.Errors > 0 {
+		return exitStatus(1)
+	}
+	return nil
+}
+
+// ReportFinding is one error or warning of one resource in a health report
+type ReportFinding struct {
+	Context   string `json:"context"`
+	Namespace string `json:"namespace,omitempty"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Severity  string `json:"severity"`       // "error" or "warning"
+	Rule      string `json:"rule,omitempty"` // Health rule that fired, empty for built-in checks
+	Message   string `json:"message"`
+}
+
+// ReportContext summarizes the scan of one context
+type ReportContext struct {
+	Name       string   `json:"name"`
+	Resources  int      `json:"resources"` // Resources checked
+	Errors     int      `json:"errors"`
+	Warnings   int      `json:"warnings"`
+	ScanErrors []string `json:"scanErrors,omitempty"` // Kinds, namespaces or the whole context that could not be scanned
+	Failed     bool     `json:"failed,omitempty"`     // The context could not be connected to at all
+}
+
+// HealthReport is the result of 'k8sgo report'
+type HealthReport struct {
+	GeneratedAt time.Time       `json:"generatedAt"`
+	MinSeverity string          `json:"minSeverity"`
+	Contexts    []ReportContext `json:"contexts"`
+	Findings    []ReportFinding `json:"findings"`
+	Ignored     int             `json:"ignored"` // Findings dropped by the ignore list
+}
+
+// IgnoreRule drops the findings matching all of its non-empty fields; fields are glob patterns
+type IgnoreRule struct {
+	Context   string `json:"context,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Kind      string `json:"kind,omitempty"` // Kind or resource name, e.g. Pod or pods
+	Name      string `json:"name,omitempty"`
+	Rule      string `json:"rule,omitempty"`
+	Message   string `json:"message,omitempty"`
+	Reason    string `json:"reason,omitempty"` // Why the finding is accepted; documentation only
+}
+
+// loadIgnoreRules reads an ignore file with a top-level 'ignore:' list
+func loadIgnoreRules(path string) ([]IgnoreRule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file struct {
+		Ignore []IgnoreRule `json:"ignore"`
+	}
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	var problems []string
+	for i, rule := range file.Ignore {
+		patterns := []string{rule.Context, rule.Namespace, rule.Kind, rule.Name, rule.Rule, rule.Message}
+		empty := true
+		for _, pattern := range patterns {
+			if _, err := filepath.Match(pattern, ""); err != nil {
+				problems = append(problems, fmt.Sprintf("ignore[%d]: invalid pattern %q: %v", i, pattern, err))
+			}
+			empty = empty && pattern == ""
+		}
+		if empty {
+			problems = append(problems, fmt.Sprintf("ignore[%d]: matches every finding; set at least one field", i))
+		}
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("%s:\n  %s", path, strings.Join(problems, "\n  "))
+	}
+	return file.Ignore, nil
+}
+
+// matches reports whether the ignore rule covers a finding
+func (r IgnoreRule) matches(f ReportFinding) bool {
+	match := func(pattern string, values ...string) bool {
+		if pattern == "" {
+			return true
+		}
+		for _, value := range values {
+			if ok, _ := filepath.Match(strings.ToLower(pattern), strings.ToLower(value)); ok {
+				return true
+			}
+		}
+		return false
+	}
+	resourceName := f.Kind
+	if rt, ok := resourceTypeForKind(f.Kind); ok {
+		_, resourceName = rt.apiResource()
+	}
+	return match(r.Context, f.Context) && match(r.Namespace, f.Namespace) && match(r.Kind, f.Kind, resourceName) &&
+		match(r.Name, f.Name) && match(r.Rule, f.Rule) && match(r.Message, f.Message)
+}
+
+// severityRank orders severities for thresholds; unknown severities rank lowest
+func severityRank(severity string) int {
+	switch severity {
+	case "error":
+		return 2
+	case "warning":
+		return 1
+	}
+	return 0
+}
+
+// resourceFindings turns the rule findings of a resource into report findings
+func resourceFindings(contextName string, res K8sResource) []ReportFinding {
+	var findings []ReportFinding
+	for _, rf := range res.Findings {
+		findings = append(findings, ReportFinding{Context: contextName, Namespace: res.Namespace, Kind: kindLabel(res.ResourceType),
+			Name: res.Name, Severity: rf.Severity, Rule: rf.Rule, Message: rf.Message})
+	}
+	return findings
+}
+
+// scanContextReport collects the findings of the given kinds in one context; namespaces nil means all
+func scanContextReport(contextName string, kinds []ResourceType, namespaces []string) (ReportContext, []ReportFinding) {
+	summary := ReportContext{Name: contextName}
+	m, err := headlessModel(contextName)
+	if err != nil {
+		summary.Failed = true
+		summary.ScanErrors = append(summary.ScanErrors, err.Error())
+		return summary, nil
+	}
+	scan := m.scanFindings(kinds, namespaces)
+	for _, count := range scan.Checked {
+		summary.Resources += count
+	}
+	for _, failure := range scan.Failures {
+		summary.ScanErrors = append(summary.ScanErrors, failure.String())
+	}
+	return summary, scan.Findings
+}
+
+// findingScan is the result of listing the report kinds of one context
+type findingScan struct {
+	Findings []ReportFinding
+	Checked  map[ResourceType]int // Resources listed per kind
+	Failures []scanFailure
+}
+
+// scanFailure is a kind that could not be listed in a namespace ("" for all)
+type scanFailure struct {
+	Kind      ResourceType
+	Namespace string
+	Err       error
+}
+
+func (f scanFailure) String() string {
+	where := f.Kind.String()
+	if f.Namespace != "" {
+		where += " in " + f.Namespace
+	}
+	return fmt.Sprintf("%s: %v", where, f.Err)
+}
+
+// scanFindings lists the kinds (default: the dashboard's) in the namespaces (nil for all) and collects their findings
+func (m Model) scanFindings(kinds []ResourceType, namespaces []string) findingScan {
+	m.selectors = nil
+	if len(kinds) == 0 {
+		kinds = append([]ResourceType{}, healthScanKinds...)
+		if m.isOpenShift {
+			kinds = append(kinds, RoutesResource, DeploymentConfigsResource)
+		}
+	}
+	
+	scan := findingScan{Checked: map[ResourceType]int{}}
+	for _, rt := range kinds {
+		scopes := []string{metav1.NamespaceAll}
+		if rt.GetResourceInfo().Scope == ClusterScoped {
+			if namespaces != nil {
+				continue // Only the chosen namespaces are reported
+			}
+		} else if namespaces != nil {
+			// Listing per namespace also works for users without cluster-wide access
+			scopes = namespaces
+		}
+		for _, namespace := range scopes {
+			m.selectedNamespace = namespace
+			resources, err := m.fetchResources(rt)
+			if err != nil {
+				scan.Failures = append(scan.Failures, scanFailure{Kind: rt, Namespace: namespace, Err: err})
+				continue
+			}
+			scan.Checked[rt] += len(resources)
+			for _, res := range resources {
+				scan.Findings = append(scan.Findings, resourceFindings(m.selectedKubeContext, res)...)
+			}
+		}
+	}
+	return scan
+}
+
+// buildHealthReport scans every context, then applies the severity threshold and the ignore list
+func buildHealthReport(contexts []string, kinds []ResourceType, namespaces []string, minSeverity string, ignore []IgnoreRule) HealthReport {
+	report := HealthReport{GeneratedAt: time.Now().UTC(), MinSeverity: minSeverity, Findings: []ReportFinding{}}
+	for _, contextName := range contexts {
+		summary, findings := scanContextReport(contextName, kinds, namespaces)
+		for _, finding := range findings {
+			if severityRank(finding.Severity) < severityRank(minSeverity) {
+				continue
+			}
+			ignored := false
+			for _, rule := range ignore {
+				if rule.matches(finding) {
+					ignored = true
+					break
+				}
+			}
+			if ignored {
+				report.Ignored++
+				continue
+			}
+			if finding.Severity == "error" {
+				summary.Errors++
+			} else {
+				summary.Warnings++
+			}
+			report.Findings = append(report.Findings, finding)
+		}
+		report.Contexts = append(report.Contexts, summary)
+	}
+	
+	sort.SliceStable(report.Findings, func(i, j int) bool {
+		a, b := report.Findings[i], report.Findings[j]
+		if a.Context != b.Context {
+			return a.Context < b.Context
+		}
+		if a.Severity != b.Severity {
+			return severityRank(a.Severity) > severityRank(b.Severity)
+		}
+		return a.Namespace+"/"+a.Kind+"/"+a.Name < b.Namespace+"/"+b.Kind+"/"+b.Name
+	})
+	return report
+}
+
+// markdownCell escapes text for a Markdown table cell
+func markdownCell(text string) string {
+	if text == "" {
+		return "-"
+	}
+	return strings.NewReplacer("|", "\\|", "\n", " ").Replace(text)
+}
+
+// writeMarkdown renders the report for humans
+func (r HealthReport) writeMarkdown(w io.Writer) {
+	errorCount, warningCount := 0, 0
+	for _, c := range r.Contexts {
+		errorCount += c.Errors
+		warningCount += c.Warnings
+	}
+	fmt.Fprintf(w, "# k8sGo health report\n\n")
+	fmt.Fprintf(w, "Generated %s · %d error(s) · %d warning(s) · %d ignored · minimum severity: %s\n\n",
+		r.GeneratedAt.Format("2006-01-02 15:04 MST"), errorCount, warningCount, r.Ignored, r.MinSeverity)
+	
+	for _, c := range r.Contexts {
+		fmt.Fprintf(w, "## Context `%s`\n\n", c.Name)
+		if c.Failed {
+			fmt.Fprintf(w, "❌ Could not scan this context: %s\n\n", markdownCell(strings.Join(c.ScanErrors, "; ")))
+			continue
+		}
+		fmt.Fprintf(w, "%d resource(s) checked · %d error(s) · %d warning(s)\n\n", c.Resources, c.Errors, c.Warnings)
+		if c.Errors+c.Warnings == 0 {
+			fmt.Fprintf(w, "✅ No findings\n\n")
+		} else {
+			fmt.Fprintf(w, "| Severity | Namespace | Kind | Name | Finding | Rule |\n")
+			fmt.Fprintf(w, "|----------|-----------|------|------|---------|------|\n")
+			for _, f := range r.Findings {
+				if f.Context != c.Name {
+					continue
+				}
+				icon := "⚠️ warning"
+				if f.Severity == "error" {
+					icon = "❌ error"
+				}
+				fmt.Fprintf(w, "| %s | %s | %s | %s | %s | %s |\n", icon, markdownCell(f.Namespace), f.Kind,
+					markdownCell(f.Name), markdownCell(f.Message), markdownCell(f.Rule))
+			}
+			fmt.Fprintln(w)
+		}
+		if len(c.ScanErrors) > 0 {
+			fmt.Fprintf(w, "Not scanned:\n\n")
+			for _, e := range c.ScanErrors {
+				fmt.Fprintf(w, "- %s\n", markdownCell(e))
+			}
+			fmt.Fprintln(w)
+		}
+	}
+}
+
+// JUnit XML documents, as read by CI dashboards
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// writeJUnit renders one test suite per context and one test case per resource with findings.
+// Findings at or above failOn fail the test case; the others are attached as output.
+func (r HealthReport) writeJUnit(w io.Writer, failOn string) error {
+	doc := junitTestSuites{Name: "k8sgo report"}
+	for _, c := range r.Contexts {
+		suite := junitTestSuite{Name: c.Name, Timestamp: r.GeneratedAt.Format(time.RFC3339)}
+		for _, e := range c.ScanErrors {
+			suite.Cases = append(suite.Cases, junitTestCase{Name: "scan", Classname: c.Name,
+				Error: &junitMessage{Message: e, Type: "ScanError", Text: e}})
+			suite.Errors++
+		}
+		
+		// Group the findings of each resource into one test case
+		var order []string
+		byResource := map[string][]ReportFinding{}
+		for _, f := range r.Findings {
+			if f.Context != c.Name {
+				continue
+			}
+			key := f.Namespace + "/" + f.Kind + "/" + f.Name
+			if _, ok := byResource[key]; !ok {
+				order = append(order, key)
+			}
+			byResource[key] = append(byResource[key], f)
+		}
+		for _, key := range order {
+			findings := byResource[key]
+			first := findings[0]
+			classname := c.Name + "." + first.Kind
+			if first.Namespace != "" {
+				classname = c.Name + "." + first.Namespace + "." + first.Kind
+			}
+			tc := junitTestCase{Name: first.Name, Classname: classname}
+			var failing, other []string
+			for _, f := range findings {
+				line := fmt.Sprintf("%s: %s", f.Severity, f.Message)
+				if f.Rule != "" {
+					line += " [" + f.Rule + "]"
+				}
+				if failOn != "never" && severityRank(f.Severity) >= severityRank(failOn) {
+					failing = append(failing, line)
+				} else {
+					other = append(other, line)
+				}
+			}
+			if len(failing) > 0 {
+				tc.Failure = &junitMessage{Message: failing[0], Type: first.Severity, Text: strings.Join(failing, "\n")}
+				suite.Failures++
+			}
+			tc.SystemOut = strings.Join(other, "\n")
+			suite.Cases = append(suite.Cases, tc)
+		}
+		if len(suite.Cases) == 0 {
+			// A passing test case so dashboards show healthy contexts too
+			suite.Cases = append(suite.Cases, junitTestCase{Name: fmt.Sprintf("%d resource(s) checked", c.Resources), Classname: c.Name})
+		}
+		suite.Tests = len(suite.Cases)
+		doc.Tests += suite.Tests
+		doc.Failures += suite.Failures
+		doc.Errors += suite.Errors
+		doc.Suites = append(doc.Suites, suite)
+	}
+	
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "%s%s\n", xml.Header, data)
+	return nil
+}
+
+// reportUsage is printed for 'k8sgo report -h' and usage errors
+const reportUsage = `Usage: k8sgo report [flags]
+
+Scans contexts, namespaces and kinds and reports every error and warning finding.
+Exits 1 when a finding reaches --fail-on, 2 when a context cannot be scanned.
+
+Flags:
+  -c, --context NAMES     comma-separated contexts (default: current)
+      --all-contexts      every context in the kubeconfig
+  -n, --namespace NAMES   comma-separated namespaces (default: all; cluster-scoped kinds are skipped)
+      --kinds KINDS       comma-separated kinds (default: the dashboard's kinds)
+      --min-severity SEV  warning or error (default warning)
+      --fail-on SEV       error, warning or never (default error)
+      --ignore FILE       YAML file with an 'ignore:' list of findings to drop
+  -o, --format FORMAT     markdown, json or junit (default markdown)
+      --kubeconfig PATH   kubeconfig file
+
+Example: k8sgo report --all-contexts --ignore ignore.yaml -o junit > report.xml
+`
+
+// splitList splits a comma-separated flag value, dropping empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseKindList resolves a comma-separated --kinds value; empty yields nil
+func parseKindList(value string) ([]ResourceType, []string) {
+	var kinds []ResourceType
+	var problems []string
+	for _, name := range splitList(value) {
+		rt, ok := staticKindAliases()[strings.ToLower(name)]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("--kinds: unknown resource type %q", name))
+			continue
+		}
+		if !hasLoader(rt) {
+			problems = append(problems, fmt.Sprintf("--kinds: listing %s is not supported yet", rt.GetResourceInfo().Name))
+			continue
+		}
+		kinds = append(kinds, rt)
+	}
+	return kinds, problems
+}
+
+// selectContexts resolves --context and --all-contexts; the default is the current context
+func selectContexts(value string, all bool) ([]string, []string) {
+	var problems []string
+	known := kubeconfigContexts()
+	sort.Strings(known)
+	contexts := splitList(value)
+	switch {
+	case all && len(contexts) > 0:
+		problems = append(problems, "--context and --all-contexts are mutually exclusive")
+	case all:
+		contexts = known
+	case len(contexts) == 0:
+		if kubeconfig, err := clientcmd.NewDefaultClientConfigLoadingRules().Load(); err == nil {
+			contexts = []string{kubeconfig.CurrentContext}
+		}
+	}
+	if len(contexts) == 0 && !all {
+		problems = append(problems, "no current context in the kubeconfig; use --context or --all-contexts")
+	}
+	for _, name := range contexts {
+		if known != nil && !containsString(known, name) {
+			problems = append(problems, fmt.Sprintf("--context: context %q not found in kubeconfig", name))
 		}
-		
-		return logsLoadedMsg{logs: logs, err: nil}
 	}
+	return contexts, problems
 }
 
-func (m Model) loadEventsCmd() tea.Cmd {
-	return func() tea.Msg {
-		var events []EventEntry
-		
-		if m.selectedK8sResource == nil {
-			return eventsLoadedMsg{events: events, err: nil}
+// runReport scans contexts for findings and writes a Markdown, JSON or JUnit report
+func runReport(args []string) error {
+	var contextList, namespaceList, kindList, minSeverity, failOn, ignoreFile, format, kubeconfig string
+	var allContexts bool
+	fs := flag.NewFlagSet("report", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	for _, name := range []string{"context", "c"} {
+		fs.StringVar(&contextList, name, "", "")
+	}
+	fs.BoolVar(&allContexts, "all-contexts", false, "")
+	for _, name := range []string{"namespace", "n"} {
+		fs.StringVar(&namespaceList, name, "", "")
+	}
+	fs.StringVar(&kindList, "kinds", "", "")
+	fs.StringVar(&minSeverity, "min-severity", "warning", "")
+	fs.StringVar(&failOn, "fail-on", "error", "")
+	fs.StringVar(&ignoreFile, "ignore", "", "")
+	for _, name := range []string{"format", "o"} {
+		fs.StringVar(&format, name, "markdown", "")
+	}
+	fs.StringVar(&kubeconfig, "kubeconfig", "", "")
+	
+	positional, err := parseInterspersed(fs, args)
+	if err == flag.ErrHelp {
+		fmt.Print(reportUsage)
+		return nil
+	}
+	if err == nil && len(positional) > 0 {
+		err = fmt.Errorf("unexpected argument %q", positional[0])
+	}
+	if err != nil {
+		return fmt.Errorf("%v\n\n%s", err, reportUsage)
+	}
+	if kubeconfig != "" {
+		os.Setenv("KUBECONFIG", kubeconfig)
+	}
+	
+	var problems []string
+	if !containsString([]string{"markdown", "json", "junit"}, format) {
+		problems = append(problems, fmt.Sprintf("--format: unknown format %q (use markdown, json or junit)", format))
+	}
+	if severityRank(minSeverity) == 0 {
+		problems = append(problems, fmt.Sprintf("--min-severity: unknown severity %q (use warning or error)", minSeverity))
+	}
+	if failOn != "never" && severityRank(failOn) == 0 {
+		problems = append(problems, fmt.Sprintf("--fail-on: unknown severity %q (use error, warning or never)", failOn))
+	}
+	kinds, kindProblems := parseKindList(kindList)
+	problems = append(problems, kindProblems...)
+	contexts, contextProblems := selectContexts(contextList, allContexts)
+	problems = append(problems, contextProblems...)
+	var ignore []IgnoreRule
+	if ignoreFile != "" {
+		if ignore, err = loadIgnoreRules(ignoreFile); err != nil {
+			problems = append(problems, fmt.Sprintf("--ignore: %v", err))
 		}
-		
-		// Load events related to the selected resource
-		eventList, err := m.clientset.CoreV1().Events(m.selectedNamespace).List(m.ctx, metav1.ListOptions{
-			FieldSelector: fmt.Sprintf("involvedObject.name=%s", m.selectedK8sResource.Name),
-		})
-		
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%s", strings.Join(problems, "\n  "))
+	}
+	
+	report := buildHealthReport(contexts, kinds, splitList(namespaceList), minSeverity, ignore)
+	switch format {
+	case "json":
+		data, err := json.MarshalIndent(report, "", "  ")
 		if err != nil {
-			return eventsLoadedMsg{events: events, err: err}
+			return err
 		}
-		
-		// Convert Kubernetes events to our EventEntry format
-		for _, event := range eventList.Items {
-			entry := EventEntry{
-				Timestamp: event.CreationTimestamp.Time,
-				Type:      event.Type,
-				Reason:    event.Reason,
-				Message:   event.Message,
-				Source:    event.Source.Component,
-				Count:     event.Count,
-			}
-			events = append(events, entry)
+		fmt.Println(string(data))
+	case "junit":
+		if err := report.writeJUnit(os.Stdout, failOn); err != nil {
+			return err
 		}
-		
-		// Sort events by timestamp (newest first)
-		sort.Slice(events, func(i, j int) bool {
-			return events[i].Timestamp.After(events[j].Timestamp)
-		})
-		
-		return eventsLoadedMsg{events: events, err: nil}
+	default:
+		report.writeMarkdown(os.Stdout)
 	}
-}
-func (m Model) renderLogs() string { return "Logs view not implemented yet" }
-func (m Model) renderEvents() string {
-	var content strings.Builder
 	
-	// Header
-	headerText := "📢 Events"
-	if m.selectedK8sResource != nil {
-		headerText = fmt.Sprintf("📢 Events - %s", m.selectedK8sResource.Name)
+	for _, c := range report.Contexts {
+		if c.Failed {
+			return exitStatus(2)
+		}
 	}
+	for _, f := range report.Findings {
+		if failOn != "never" && severityRank(f.Severity) >= severityRank(failOn) {
+			return exitStatus(1)
+		}
+	}
+	return nil
+}
+
+// exporterState is the last scan of one context as served on /metrics
+type exporterState struct {
+	Up        bool // The last scan could reach the cluster
+	Scan      findingScan
+	Duration  time.Duration
+	ScannedAt time.Time
+	Scans     int                  // Counter of completed scans
+	APIErrors map[ResourceType]int // Counter of failed list calls per kind
+	Connects  int                  // Counter of failed connection attempts
+}
+
+// exporter scans contexts on an interval and serves the findings as Prometheus metrics
+type exporter struct {
+	contexts   []string
+	kinds      []ResourceType
+	namespaces []string
+	connect    func(contextName string) (Model, error) // headlessModel, or a fake clientset in tests
 	
-	headerStyle := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(colors.Success).
-		Margin(0, 0, 1, 0)
-	
-	content.WriteString(headerStyle.Render(headerText) + "\n")
-	
-	if m.selectedK8sResource == nil {
-		content.WriteString("No resource selected for events\n")
-		return content.String()
+	mu     sync.Mutex
+	models map[string]Model // Connected contexts, reused between scans
+	states map[string]*exporterState
+}
+
+// newExporter creates an exporter for the contexts; kinds nil means the dashboard's kinds, namespaces nil all namespaces
+func newExporter(contexts []string, kinds []ResourceType, namespaces []string, connect func(string) (Model, error)) *exporter {
+	return &exporter{
+		contexts:   contexts,
+		kinds:      kinds,
+		namespaces: namespaces,
+		connect:    connect,
+		models:     map[string]Model{},
+		states:     map[string]*exporterState{},
+	}
+}
+
+// scanAll scans every context once
+func (e *exporter) scanAll() {
+	for _, contextName := range e.contexts {
+		e.scan(contextName)
+	}
+}
+
+// scan lists the kinds of one context and replaces its served state
+func (e *exporter) scan(contextName string) {
+	e.mu.Lock()
+	state := e.states[contextName]
+	if state == nil {
+		state = &exporterState{APIErrors: map[ResourceType]int{}}
+		e.states[contextName] = state
 	}
+	m, connected := e.models[contextName]
+	e.mu.Unlock()
 	
-	if len(m.eventEntries) == 0 {
-		if m.loading {
-			content.WriteString("Loading events...\n")
-		} else {
-			content.WriteString("No events found for this resource\n")
+	start := time.Now()
+	if !connected {
+		var err error
+		if m, err = e.connect(contextName); err != nil {
+			log.Printf("exporter: context %s: %v", contextName, err)
+			e.mu.Lock()
+			state.Up = false
+			state.Connects++
+			e.mu.Unlock()
+			return
 		}
-		return content.String()
+	}
+	scan := m.scanFindings(e.kinds, e.namespaces)
+	duration := time.Since(start)
+	for _, failure := range scan.Failures {
+		log.Printf("exporter: context %s: %s", contextName, failure)
 	}
 	
-	// Calculate visible range based on scroll offset
-	maxVisible := m.height - 10
-	if maxVisible < 5 {
-		maxVisible = 5
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	// Reconnect next time when nothing could be listed, e.g. after the credentials expired
+	state.Up = len(scan.Failures) == 0 || len(scan.Checked) > 0
+	if state.Up {
+		e.models[contextName] = m
+	} else {
+		delete(e.models, contextName)
+	}
+	for _, failure := range scan.Failures {
+		state.APIErrors[failure.Kind]++
+	}
+	state.Scan = scan
+	state.Duration = duration
+	state.ScannedAt = time.Now()
+	state.Scans++
+}
+
+// metricLabels renders a Prometheus label set from name/value pairs
+func metricLabels(pairs ...string) string {
+	escape := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	var labels []string
+	for i := 0; i+1 < len(pairs); i += 2 {
+		labels = append(labels, fmt.Sprintf(`%s="%s"`, pairs[i], escape.Replace(pairs[i+1])))
 	}
+	return "{" + strings.Join(labels, ",") + "}"
+}
+
+// writeMetrics renders the last scans in the Prometheus text format
+func (e *exporter) writeMetrics(w io.Writer) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
 	
-	startIdx := m.eventScrollOffset
-	endIdx := min(startIdx + maxVisible, len(m.eventEntries))
+	type sample struct {
+		labels string
+		value  float64
+	}
+	metric := func(name, kind, help string, samples []sample) {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+		sort.Slice(samples, func(i, j int) bool { return samples[i].labels < samples[j].labels })
+		for _, s := range samples {
+			fmt.Fprintf(w, "%s%s %s\n", name, s.labels, strconv.FormatFloat(s.value, 'f', -1, 64))
+		}
+	}
 	
-	// Render events
-	for i := startIdx; i < endIdx; i++ {
-		event := m.eventEntries[i]
-		
-		// Format timestamp
-		timestamp := event.Timestamp.Format("15:04:05")
-		
-		// Choose color based on event type
-		var eventStyle lipgloss.Style
-		switch event.Type {
-		case "Warning":
-			eventStyle = lipgloss.NewStyle().Foreground(colors.Warning)
-		case "Normal":
-			eventStyle = lipgloss.NewStyle().Foreground(colors.Success)
-		default:
-			eventStyle = lipgloss.NewStyle().Foreground(colors.Info)
+	var up, duration, timestamp, scans, connects, apiErrors, checked, resourceErrors, resourceWarnings, rules []sample
+	for _, contextName := range e.contexts {
+		state := e.states[contextName]
+		if state == nil {
+			continue // Not scanned yet
 		}
-		
-		// Format event entry
-		eventLine := fmt.Sprintf("[%s] %s: %s", timestamp, event.Reason, event.Message)
-		if event.Count > 1 {
-			eventLine = fmt.Sprintf("[%s] %s: %s (x%d)", timestamp, event.Reason, event.Message, event.Count)
+		ctx := []string{"context", contextName}
+		up = append(up, sample{metricLabels(ctx...), map[bool]float64{true: 1, false: 0}[state.Up]})
+		scans = append(scans, sample{metricLabels(ctx...), float64(state.Scans)})
+		connects = append(connects, sample{metricLabels(ctx...), float64(state.Connects)})
+		if state.Scans == 0 {
+			continue
+		}
+		duration = append(duration, sample{metricLabels(ctx...), state.Duration.Seconds()})
+		timestamp = append(timestamp, sample{metricLabels(ctx...), float64(state.ScannedAt.Unix())})
+		for rt, count := range state.APIErrors {
+			apiErrors = append(apiErrors, sample{metricLabels("context", contextName, "kind", kindLabel(rt)), float64(count)})
+		}
+		for rt, count := range state.Scan.Checked {
+			checked = append(checked, sample{metricLabels("context", contextName, "kind", kindLabel(rt)), float64(count)})
 		}
 		
-		content.WriteString(eventStyle.Render(eventLine) + "\n")
-	}
-	
-	// Show scroll indicator if there are more events
-	if len(m.eventEntries) > maxVisible {
-		scrollInfo := fmt.Sprintf("Showing %d-%d of %d events", startIdx+1, endIdx, len(m.eventEntries))
-		scrollStyle := lipgloss.NewStyle().Foreground(colors.Muted).Italic(true)
-		content.WriteString("\n" + scrollStyle.Render(scrollInfo) + "\n")
+		// Findings per resource, and per rule
+		type resourceKey struct{ namespace, kind, name string }
+		type ruleKey struct{ rule, severity string }
+		errorCounts, warningCounts := map[resourceKey]int{}, map[resourceKey]int{}
+		ruleCounts := map[ruleKey]int{}
+		for _, f := range state.Scan.Findings {
+			key := resourceKey{f.Namespace, f.Kind, f.Name}
+			if f.Severity == "error" {
+				errorCounts[key]++
+			} else {
+				warningCounts[key]++
+			}
+			ruleCounts[ruleKey{f.Rule, f.Severity}]++
+		}
+		for key, count := range errorCounts {
+			resourceErrors = append(resourceErrors, sample{metricLabels("context", contextName, "namespace", key.namespace,
+				"kind", key.kind, "name", key.name), float64(count)})
+		}
+		for key, count := range warningCounts {
+			resourceWarnings = append(resourceWarnings, sample{metricLabels("context", contextName, "namespace", key.namespace,
+				"kind", key.kind, "name", key.name), float64(count)})
+		}
+		for key, count := range ruleCounts {
+			rules = append(rules, sample{metricLabels("context", contextName, "rule", key.rule, "severity", key.severity), float64(count)})
+		}
 	}
 	
-	return content.String()
+	metric("k8sgo_up", "gauge", "Whether the last scan of the context reached the cluster.", up)
+	metric("k8sgo_resource_errors", "gauge", "Error findings of a resource; resources without errors are omitted.", resourceErrors)
+	metric("k8sgo_resource_warnings", "gauge", "Warning findings of a resource; resources without warnings are omitted.", resourceWarnings)
+	metric("k8sgo_rule_findings", "gauge", "Findings per health rule and severity.", rules)
+	metric("k8sgo_resources", "gauge", "Resources checked per kind in the last scan.", checked)
+	metric("k8sgo_scrape_duration_seconds", "gauge", "Duration of the last scan of the context.", duration)
+	metric("k8sgo_last_scrape_timestamp_seconds", "gauge", "Unix time the last scan of the context finished.", timestamp)
+	metric("k8sgo_scrapes_total", "counter", "Scans of the context.", scans)
+	metric("k8sgo_api_errors_total", "counter", "Failed list calls per kind.", apiErrors)
+	metric("k8sgo_connect_errors_total", "counter", "Failed attempts to connect to the context.", connects)
 }
 
-// Utility functions
-func truncateString(s string, maxLen int) string {
-	if len(s) <= maxLen {
-		return s
+// ServeHTTP serves /metrics and a short index page
+func (e *exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/metrics":
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		e.writeMetrics(w)
+	case "/":
+		fmt.Fprintf(w, "<html><body><h1>k8sGo exporter</h1><p>Contexts: %s</p><p><a href=\"/metrics\">Metrics</a></p></body></html>\n",
+			html.EscapeString(strings.Join(e.contexts, ", ")))
+	default:
+		http.NotFound(w, r)
 	}
-	return s[:maxLen-3] + "..."
 }
 
-func min(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}
+// exporterUsage is printed for 'k8sgo exporter -h' and usage errors
+const exporterUsage = `Usage: k8sgo exporter [flags]
 
-// humanAge formats duration into human-readable age string
-func humanAge(d time.Duration) string {
-	s := int(d.Seconds())
-	m := s / 60
-	h := m / 60
-	days := h / 24
+Scans contexts on an interval and serves the health findings as Prometheus metrics on /metrics.
 
-	switch {
-	case days > 0:
-		return fmt.Sprintf("%dd", days)
-	case h > 0:
-		return fmt.Sprintf("%dh", h)
-	case m > 0:
-		return fmt.Sprintf("%dm", m)
-	default:
-		return fmt.Sprintf("%ds", s)
+Flags:
+      --listen ADDR       address to serve on (default :9191)
+      --interval DURATION time between scans (default 60s)
+      --once              scan once, print the metrics and exit
+  -c, --context NAMES     comma-separated contexts (default: current)
+      --all-contexts      every context in the kubeconfig
+  -n, --namespace NAMES   comma-separated namespaces (default: all; cluster-scoped kinds are skipped)
+      --kinds KINDS       comma-separated kinds (default: the dashboard's kinds)
+      --kubeconfig PATH   kubeconfig file
+
+Example: k8sgo exporter --listen :9191 --all-contexts --interval 2m
+`
+
+// runExporter scans on an interval and serves /metrics until interrupted
+func runExporter(args []string) error {
+	var listen, interval, contextList, namespaceList, kindList, kubeconfig string
+	var allContexts, once bool
+	fs := flag.NewFlagSet("exporter", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.StringVar(&listen, "listen", ":9191", "")
+	fs.StringVar(&interval, "interval", "60s", "")
+	fs.BoolVar(&once, "once", false, "")
+	for _, name := range []string{"context", "c"} {
+		fs.StringVar(&contextList, name, "", "")
 	}
+	fs.BoolVar(&allContexts, "all-contexts", false, "")
+	for _, name := range []string{"namespace", "n"} {
+		fs.StringVar(&namespaceList, name, "", "")
+	}
+	fs.StringVar(&kindList, "kinds", "", "")
+	fs.StringVar(&kubeconfig, "kubeconfig", "", "")
+	
+	positional, err := parseInterspersed(fs, args)
+	if err == flag.ErrHelp {
+		fmt.Print(exporterUsage)
+		return nil
+	}
+	if err == nil && len(positional) > 0 {
+		err = fmt.Errorf("unexpected argument %q", positional[0])
+	}
+	if err != nil {
+		return fmt.Errorf("%v\n\n%s", err, exporterUsage)
+	}
+	if kubeconfig != "" {
+		os.Setenv("KUBECONFIG", kubeconfig)
+	}
+	
+	var problems []string
+	period, err := time.ParseDuration(interval)
+	if err != nil || period < 10*time.Second {
+		problems = append(problems, fmt.Sprintf("--interval: %q is not a duration of at least 10s (e.g. 30s, 2m)", interval))
+	}
+	kinds, kindProblems := parseKindList(kindList)
+	problems = append(problems, kindProblems...)
+	contexts, contextProblems := selectContexts(contextList, allContexts)
+	problems = append(problems, contextProblems...)
+	if len(problems) > 0 {
+		return fmt.Errorf("%s", strings.Join(problems, "\n  "))
+	}
+	
+	e := newExporter(contexts, kinds, splitList(namespaceList), headlessModel)
+	if once {
+		e.scanAll()
+		e.writeMetrics(os.Stdout)
+		return nil
+	}
+	go func() {
+		for {
+			e.scanAll()
+			time.Sleep(period)
+		}
+	}()
+	log.Printf("exporter: serving metrics for %s on %s/metrics", strings.Join(contexts, ", "), listen)
+	server := &http.Server{
+		Addr:              listen,
+		Handler:           e,
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       10 * time.Second,
+		WriteTimeout:      30 * time.Second,
+	}
+	return server.ListenAndServe()
 }
 
 // initializeKubernetesClient creates a Kubernetes client from kubeconfig
-func initializeKubernetesClient() (*kubernetes.Clientset, error) {
+func initializeKubernetesClient() (kubernetes.Interface, error) {
 	config, err := getKubernetesConfig()
 	if err != nil {
 		return nil, err
@@ -2131,81 +13097,142 @@ func initializeKubernetesClient() (*kubernetes.Clientset, error) {
 	return clientset, nil
 }
 
-// getKubernetesConfig loads Kubernetes configuration from kubeconfig or in-cluster config
+// getKubernetesConfig loads Kubernetes configuration for the current context or in-cluster config
 func getKubernetesConfig() (*rest.Config, error) {
-	// Try in-cluster config first
-	config, err := rest.InClusterConfig()
-	if err == nil {
-		return config, nil
-	}
+	return kubernetesConfigFor("")
+}
 
-	// Fall back to kubeconfig
-	var kubeconfig string
-	if home := homedir.HomeDir(); home != "" {
-		kubeconfig = filepath.Join(home, ".kube", "config")
+// kubernetesConfigFor loads the configuration of a kubeconfig context, "" for the current context.
+// The kubeconfig comes from $KUBECONFIG (set by --kubeconfig) or ~/.kube/config.
+func kubernetesConfigFor(contextName string) (*rest.Config, error) {
+	// Try in-cluster config first unless a kubeconfig or context was asked for
+	if contextName == "" && os.Getenv("KUBECONFIG") == "" {
+		if config, err := rest.InClusterConfig(); err == nil {
+			return config, nil
+		}
 	}
 	
-	if _, err := os.Stat(kubeconfig); err == nil {
-		config, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
-		if err != nil {
-			return nil, fmt.Errorf("failed to build config from kubeconfig: %v", err)
-		}
-		return config, nil
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: contextName}
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("unable to load Kubernetes configuration: %v", err)
 	}
-
-	return nil, fmt.Errorf("unable to load Kubernetes configuration")
+	return config, nil
 }
 
 // reinitializeClients creates new clients after context switch
 func (m Model) reinitializeClients(contextName string) tea.Cmd {
-	return func() tea.Msg {
-		// Initialize new Kubernetes client with the switched context
-		clientset, err := initializeKubernetesClient()
-		if err != nil {
-			return clientsReinitializedMsg{contextName: contextName, err: fmt.Errorf("failed to initialize Kubernetes client: %v", err)}
-		}
-
-		// Try to initialize OpenShift clients with the new context
-		config, err := getKubernetesConfig()
-		var openshiftAppsClient *openshiftclient.Clientset
-		var routeClient *routeclient.Clientset  
-		var projectClient *projectclient.Clientset
-		var isOpenShift bool = false
-		
-		if err == nil {
-			openshiftAppsClient, _ = openshiftclient.NewForConfig(config)
-			routeClient, _ = routeclient.NewForConfig(config)
-			projectClient, _ = projectclient.NewForConfig(config)
-			
-			// Test if we're on OpenShift by trying to list projects
-			if projectClient != nil {
-				_, err := projectClient.ProjectV1().Projects().List(context.Background(), metav1.ListOptions{Limit: 1})
-				isOpenShift = err == nil
-			}
+	if m.replay != nil {
+		clientset, err := m.replay.clientset(m.replayFrame)
+		return func() tea.Msg {
+			return clientsReinitializedMsg{contextName: contextName, clientset: clientset, err: err}
 		}
+	}
+	return func() tea.Msg {
+		return connectContext(contextName)
+	}
+}
 
-		// Return message with all the new client information
-		return clientsReinitializedMsg{
-			contextName:         contextName,
-			clientset:          clientset,
-			openshiftAppsClient: openshiftAppsClient,
-			routeClient:        routeClient,
-			projectClient:      projectClient,
-			isOpenShift:        isOpenShift,
-			err:                nil,
-		}
+// connectContext creates the Kubernetes and, when available, OpenShift clients for a context
+func connectContext(contextName string) clientsReinitializedMsg {
+	// Initialize new Kubernetes client with the switched context
+	config, err := kubernetesConfigFor(contextName)
+	if err != nil {
+		return clientsReinitializedMsg{contextName: contextName, err: fmt.Errorf("failed to initialize Kubernetes client: %v", err)}
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return clientsReinitializedMsg{contextName: contextName, err: fmt.Errorf("failed to initialize Kubernetes client: %v", err)}
+	}
+	
+	// Try to initialize OpenShift clients with the new context
+	openshiftAppsClient, _ := openshiftclient.NewForConfig(config)
+	routeClient, _ := routeclient.NewForConfig(config)
+	projectClient, _ := projectclient.NewForConfig(config)
+	
+	// Test if we're on OpenShift by trying to list projects
+	isOpenShift := false
+	if projectClient != nil {
+		_, err := projectClient.ProjectV1().Projects().List(context.Background(), metav1.ListOptions{Limit: 1})
+		isOpenShift = err == nil
+	}
+	
+	// Return message with all the new client information
+	return clientsReinitializedMsg{
+		contextName:         contextName,
+		clientset:           clientset,
+		openshiftAppsClient: openshiftAppsClient,
+		routeClient:         routeClient,
+		projectClient:       projectClient,
+		isOpenShift:         isOpenShift,
+		err:                 nil,
 	}
 }
 
 // main function - application entry point
 func main() {
-	// Set color profile for better terminal colors
-	lipgloss.SetColorProfile(termenv.ANSI256)
+	// Subcommands such as 'k8sgo completion bash' run without the UI
+	if len(os.Args) > 1 {
+		if run, ok := subcommands[os.Args[1]]; ok {
+			if err := run(os.Args[2:]); err != nil {
+				if status, ok := err.(exitStatus); ok {
+					os.Exit(int(status))
+				}
+				fmt.Fprintf(os.Stderr, "k8sgo %s: %v\n", os.Args[1], err)
+				os.Exit(2)
+			}
+			return
+		}
+	}
+	
+	opts, err := parseFlags(os.Args[1:])
+	if err == flag.ErrHelp {
+		fmt.Print(cliUsage)
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "k8sgo: %v\n\n%s", err, cliUsage)
+		os.Exit(2)
+	}
+	if opts.Kubeconfig != "" {
+		// Every kubeconfig reader and kubectl call honours KUBECONFIG
+		os.Setenv("KUBECONFIG", opts.Kubeconfig)
+	}
+	if err := opts.validate(); err != nil {
+		log.Fatalf("Invalid flags:\n  %v", err)
+	}
+	
+	// Use the colors the terminal supports; NO_COLOR turns them off and CLICOLOR_FORCE forces them on
+	lipgloss.SetColorProfile(termenv.NewOutput(os.Stdout).EnvColorProfile())
+	// Detect the terminal background for the auto theme now; querying it once the UI reads keys would race
+	lipgloss.HasDarkBackground()
 	
 	// Banner will be shown in the UI on every page
 	
+	// A replayed snapshot or a dump stands in for the cluster
+	var replay *replayState
+	if opts.Replay != "" {
+		snapshot, err := readSnapshot(opts.Replay)
+		if err != nil {
+			log.Fatalf("Cannot replay %s: %v", opts.Replay, err)
+		}
+		replay = newReplayState(opts.Replay, snapshot)
+	}
+	if opts.Dump != "" {
+		replay, err = loadDump(opts.Dump)
+		if err != nil {
+			log.Fatalf("Cannot load %s: %v", opts.Dump, err)
+		}
+	}
+	
 	// Initialize Kubernetes client
-	clientset, err := initializeKubernetesClient()
+	var clientset kubernetes.Interface
+	if replay != nil {
+		clientset, err = replay.clientset(0)
+	} else {
+		clientset, err = initializeKubernetesClient()
+	}
 	if err != nil {
 		log.Fatalf("Failed to initialize Kubernetes client: %v", err)
 	}
@@ -2217,7 +13244,7 @@ func main() {
 	var projectClient *projectclient.Clientset
 	var isOpenShift bool = false
 	
-	if err == nil {
+	if err == nil && replay == nil {
 		openshiftAppsClient, _ = openshiftclient.NewForConfig(config)
 		routeClient, _ = routeclient.NewForConfig(config)
 		projectClient, _ = projectclient.NewForConfig(config)
@@ -2229,6 +13256,33 @@ func main() {
 		}
 	}
 	
+	// Load health rules; an invalid rules file is reported before the UI starts
+	rules, err := loadRuleSet(rulesFilePath())
+	if err != nil {
+		log.Fatalf("Invalid health rules: %v", err)
+	}
+	
+	// Load preferences; an invalid config file is reported before the UI starts
+	appConfig, err := loadConfig(configFilePath())
+	if err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	currentContext := ""
+	if replay != nil {
+		currentContext = replay.snapshot.Context
+	} else if kubeconfig, err := clientcmd.NewDefaultClientConfigLoadingRules().Load(); err == nil {
+		currentContext = kubeconfig.CurrentContext
+	}
+	settings := appConfig.effective(currentContext)
+	refresh, _ := time.ParseDuration(opts.Refresh) // Validated with the flags
+	
+	// Flags that name a view open it in the given context, or else the current one
+	startContext := opts.Context
+	if startContext == "" && (opts.startCommand() != "" || replay != nil) {
+		startContext = currentContext
+	}
+	keymap, _ := buildKeymap(appConfig.Keys) // Validated with the config
+	
 	// Create initial model
 	initialModel := Model{
 		clientset:           clientset,
@@ -2246,9 +13300,32 @@ func main() {
 		resources:           make([]K8sResource, 0),
 		logEntries:          make([]LogEntry, 0),
 		eventEntries:        make([]EventEntry, 0),
+		metricHistory:       make(map[string]*MetricSeries),
+		permissionCache:     make(map[string]*permissionSet),
+		selectors:           make(map[ResourceType]ResourceSelector),
+		sortOrders:          make(map[ResourceType]tableSort),
+		rules:               rules,
+		config:              appConfig,
+		keymap:              keymap,
+		selectedKubeContext: currentContext,
+		selectedNamespace:   configNamespace(settings.DefaultNamespace),
 		loading:             true,
-		autoRefresh:         true,
+		autoRefresh:         settings.AutoRefresh && opts.Refresh != "0",
+		refreshOverride:     refresh,
+		refreshDisabled:     opts.Refresh == "0",
+		readOnly:            opts.ReadOnly,
+		startContext:        startContext,
+		startCommand:        opts.startCommand(),
+		startSelector:       opts.Selector,
+		notifications:       newNotificationState(),
+		changes:             newChangeTracker(),
+		recorder:            newSnapshotRecorder(),
+		replay:              replay,
+	}
+	if replay != nil {
+		initialModel.noticeMessage = replay.notice
 	}
+	initialModel.applyTheme()
 	
 	// Start the Bubble Tea program
 	program := tea.NewProgram(initialModel, tea.WithAltScreen())
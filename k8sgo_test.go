@@ -0,0 +1,562 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// Run with: go test k8sgo.go k8sgo_test.go (main.go is a separate build of the same package)
+
+// fakeModel returns a headless model on a fake clientset with the built-in health rules
+func fakeModel(t *testing.T, contextName string, objects ...runtime.Object) Model {
+	rules, err := loadRuleSet(filepath.Join(t.TempDir(), "rules.yaml"))
+	if err != nil {
+		t.Fatalf("loading the built-in rules: %v", err)
+	}
+	return Model{
+		clientset:           fake.NewSimpleClientset(objects...),
+		ctx:                 context.Background(),
+		selectedKubeContext: contextName,
+		selectors:           make(map[ResourceType]ResourceSelector),
+		sortOrders:          make(map[ResourceType]tableSort),
+		metricHistory:       make(map[string]*MetricSeries),
+		permissionCache:     make(map[string]*permissionSet),
+		rules:               rules,
+		config:              &AppConfig{},
+	}
+}
+
+func TestExporterWriteMetrics(t *testing.T) {
+	crashing := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "shop", CreationTimestamp: metav1.Now()},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:         "web",
+				RestartCount: 7,
+				State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+			}},
+		},
+	}
+	healthy := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "shop", CreationTimestamp: metav1.Now()},
+		Status: corev1.PodStatus{
+			Phase:             corev1.PodRunning,
+			Conditions:        []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			ContainerStatuses: []corev1.ContainerStatus{{Name: "api", Ready: true, State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}},
+		},
+	}
+	
+	connects := 0
+	e := newExporter([]string{"test"}, []ResourceType{PodsResource}, nil, func(contextName string) (Model, error) {
+		connects++
+		return fakeModel(t, contextName, crashing, healthy), nil
+	})
+	e.scanAll()
+	e.scanAll()
+	if connects != 1 {
+		t.Errorf("connected %d times, want once with the model reused between scans", connects)
+	}
+	
+	var out strings.Builder
+	e.writeMetrics(&out)
+	metrics := out.String()
+	for _, want := range []string{
+		`k8sgo_up{context="test"} 1`,
+		`k8sgo_resources{context="test",kind="Pod"} 2`,
+		`k8sgo_rule_findings{context="test",rule="pod-crashloop",severity="error"} 1`,
+		`k8sgo_scrapes_total{context="test"} 2`,
+		`k8sgo_connect_errors_total{context="test"} 0`,
+		"# TYPE k8sgo_api_errors_total counter",
+	} {
+		if !strings.Contains(metrics, want+"\n") {
+			t.Errorf("metrics lack %q:\n%s", want, metrics)
+		}
+	}
+	if !strings.Contains(metrics, `k8sgo_resource_errors{context="test",namespace="shop",kind="Pod",name="web"} `) {
+		t.Errorf("metrics lack the errors of the crashing pod:\n%s", metrics)
+	}
+	if strings.Contains(metrics, `name="api"`) {
+		t.Errorf("metrics list findings for the healthy pod:\n%s", metrics)
+	}
+}
+
+func TestRuleExpressions(t *testing.T) {
+	type m = map[string]interface{}
+	type l = []interface{}
+	obj := m{
+		"metadata": m{"name": "web", "creationTimestamp": "2026-01-01T00:00:00Z", "labels": m{"app": "web", "tier": "front"}},
+		"spec": m{
+			"replicas":   int64(3),
+			"paused":     false,
+			"nodeName":   nil,
+			"containers": l{m{"name": "app", "image": "web:latest"}, m{"name": "proxy", "image": "envoy:1.30"}},
+		},
+		"status": m{
+			"phase":      "Running",
+			"ratio":      0.5,
+			"conditions": l{m{"type": "Ready", "status": "False", "reason": "Crashing"}, m{"type": "PodScheduled", "status": "True"}},
+			"containerStatuses": l{
+				m{"name": "app", "restartCount": int64(4), "state": m{"waiting": m{"reason": "CrashLoopBackOff"}}},
+				m{"name": "proxy", "restartCount": int64(1), "state": m{"running": m{}}},
+			},
+		},
+	}
+	now := time.Date(2026, 1, 1, 0, 10, 0, 0, time.UTC)
+	
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		// Precedence: ! binds tighter than &&, && tighter than ||, comparisons tighter than both
+		{"true || false && false", true},
+		{"(true || false) && false", false},
+		{"!false && false", false},
+		{"!(false && false)", true},
+		{"spec.replicas == 3 || spec.replicas == 4 && false", true},
+		{"!spec.paused && status.phase == 'Running'", true},
+		
+		// Paths, indexes, wildcards and filters
+		{"status.phase == 'Running'", true},
+		{"status.phase == \"Running\"", true},
+		{"spec.containers[1].name == 'proxy'", true},
+		{"spec.containers[2].name == 'proxy'", false},
+		{"spec.containers[*].name == 'proxy'", true},
+		{"metadata.labels[*] == 'front'", true},
+		{"metadata.labels['app'] == 'web'", true},
+		{"status.conditions[type == 'Ready'].status == 'False'", true},
+		{"status.conditions[type == 'Ready' && status == 'True'].reason == 'Crashing'", false},
+		{"status.conditions[type == 'Missing'].status != 'True'", false},
+		{"exists(status.conditions[type == 'PodScheduled'])", true},
+		{"exists(status.containerStatuses[exists(state.waiting)])", true},
+		{"count(status.containerStatuses[restartCount > 0]) == 2", true},
+		{"status.missing.deeper == 'x'", false},
+		
+		// Numbers, strings and nil
+		{"spec.replicas == 3.0", true},
+		{"spec.replicas > 2 && spec.replicas >= 3 && spec.replicas < 4 && spec.replicas <= 3", true},
+		{"status.ratio < 1", true},
+		{"spec.replicas == '3'", true},
+		{"status.phase > 'Pending'", true},
+		{"spec.nodeName == null", true},
+		{"spec.nodeName != null", false},
+		{"spec.nodeName < 1", false},
+		{"status.phase != null", true},
+		{"status.missing == null", false}, // A missing field has no value to compare
+		{"spec.replicas", true},
+		{"status.missing", false},
+		
+		// Regular expressions
+		{"spec.containers[*].image =~ ':latest$'", true},
+		{"status.phase =~ '^Run'", true},
+		{"status.phase =~ '^run'", false},
+		{"spec.replicas =~ '3'", false},
+		
+		// Functions
+		{"len(spec.containers) == 2", true},
+		{"len(metadata.labels) == 2", true},
+		{"len(status.phase) == 7", true},
+		{"len(status.missing) == 0", true},
+		{"sum(status.containerStatuses[*].restartCount) == 5", true},
+		{"exists(status.missing)", false},
+		{"default(status.missing, 7) == 7", true},
+		{"default(spec.replicas, 7) == 3", true},
+		{"quantity('500Mi') == 524288000", true},
+		{"quantity('250m') < 1", true},
+		{"age() == 600", true},
+	}
+	for _, tt := range tests {
+		node, err := parseRuleExpr(tt.expr)
+		if err != nil {
+			t.Errorf("%s: %v", tt.expr, err)
+			continue
+		}
+		if got := anyTruthy(node.eval(obj, now)); got != tt.want {
+			t.Errorf("%s = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestRuleExpressionErrors(t *testing.T) {
+	tests := []struct {
+		expr, want string
+	}{
+		{"", "expression is empty"},
+		{"len()", "len() takes 1 argument(s), got 0"},
+		{"len(a, b)", "len() takes 1 argument(s), got 2"},
+		{"default(a)", "default() takes 2 argument(s), got 1"},
+		{"age(a)", "age() takes 0 argument(s), got 1"},
+		{"nope(a)", `unknown function "nope"`},
+		{"a =~ b", "=~ needs a quoted regular expression"},
+		{"a =~ '('", "invalid regular expression"},
+		{"a == 'open", "unterminated string"},
+		{"a == ", "unexpected end of expression"},
+		{"a == 1 b", `unexpected "b"`},
+		{"(a == 1", `expected ")"`},
+		{"a # b", "unexpected"},
+	}
+	for _, tt := range tests {
+		_, err := parseRuleExpr(tt.expr)
+		if err == nil {
+			t.Errorf("%q parsed, want error %q", tt.expr, tt.want)
+		} else if !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%q: error %q, want %q", tt.expr, err, tt.want)
+		}
+	}
+}
+
+func TestBuiltinRules(t *testing.T) {
+	type m = map[string]interface{}
+	type l = []interface{}
+	rules, err := loadRuleSet(filepath.Join(t.TempDir(), "rules.yaml"))
+	if err != nil {
+		t.Fatalf("loading the built-in rules: %v", err)
+	}
+	now := time.Date(2026, 1, 1, 1, 0, 0, 0, time.UTC)
+	created := m{"creationTimestamp": "2026-01-01T00:00:00Z"}
+	waiting := func(reason string) m {
+		return m{"containerStatuses": l{m{"name": "app", "state": m{"waiting": m{"reason": reason}}}}}
+	}
+	replicas := func(ready, total int64) m {
+		return m{"status": m{"readyReplicas": ready, "replicas": total}}
+	}
+	condition := func(kind, status string, extra m) m {
+		cond := m{"type": kind, "status": status}
+		for key, value := range extra {
+			cond[key] = value
+		}
+		return m{"status": m{"conditions": l{cond}}}
+	}
+	
+	tests := []struct {
+		rule    string
+		kind    ResourceType
+		object  m
+		data    map[string]interface{}
+		usage   *UsageMetrics
+		message string
+	}{
+		{rule: "pod-not-ready", kind: PodsResource, object: m{"status": m{"phase": "Running", "conditions": l{m{"type": "Ready", "status": "False"}}}}, message: "Pod is not ready"},
+		{rule: "pod-unschedulable", kind: PodsResource, object: condition("PodScheduled", "False", m{"reason": "Unschedulable"})},
+		{rule: "pod-container-waiting", kind: PodsResource, object: m{"status": waiting("ContainerCreating")}},
+		{rule: "pod-container-failed", kind: PodsResource, object: m{"status": m{"containerStatuses": l{m{"state": m{"terminated": m{"exitCode": int64(1)}}}}}}},
+		{rule: "pod-restarted", kind: PodsResource, object: m{"status": m{"containerStatuses": l{m{"restartCount": int64(2)}}}}},
+		{rule: "pod-high-restarts", kind: PodsResource, object: m{"status": m{"containerStatuses": l{m{"restartCount": int64(4)}, m{"restartCount": int64(3)}}}}},
+		{rule: "pod-crashloop", kind: PodsResource, object: m{"status": waiting("CrashLoopBackOff")}},
+		{rule: "pod-image-pull", kind: PodsResource, object: m{"status": waiting("ImagePullBackOff")}},
+		{rule: "pod-oom-killed", kind: PodsResource, object: m{"status": m{"containerStatuses": l{m{"lastState": m{"terminated": m{"reason": "OOMKilled"}}}}}}},
+		{rule: "pod-pending-too-long", kind: PodsResource, object: m{"metadata": created, "status": m{"phase": "Pending"}}},
+		{rule: "pod-memory-near-limit", kind: PodsResource, object: m{"status": m{}},
+			usage:   &UsageMetrics{Containers: []UsageMetrics{{Name: "app", MemoryLimitPct: 95, CPULimitPct: -1}}},
+			message: "Container app memory at 95% of limit"},
+		{rule: "pod-cpu-near-limit", kind: PodsResource, object: m{"status": m{}},
+			usage:   &UsageMetrics{Containers: []UsageMetrics{{Name: "app", MemoryLimitPct: -1, CPULimitPct: 90}}},
+			message: "Container app CPU at 90% of limit (throttling likely)"},
+		{rule: "image-latest-tag", kind: PodsResource, object: m{"spec": m{"containers": l{m{"image": "web:latest"}}}}},
+		{rule: "deployment-unavailable", kind: DeploymentsResource, object: condition("Available", "False", nil)},
+		{rule: "deployment-replicas-not-ready", kind: DeploymentsResource, object: replicas(1, 2)},
+		{rule: "deployment-progress-deadline", kind: DeploymentsResource, object: condition("Progressing", "False", m{"reason": "ProgressDeadlineExceeded"})},
+		{rule: "replicaset-replicas-not-ready", kind: ReplicaSetsResource, object: replicas(0, 1)},
+		{rule: "statefulset-replicas-not-ready", kind: StatefulSetsResource, object: replicas(2, 3)},
+		{rule: "deploymentconfig-replicas-not-ready", kind: DeploymentConfigsResource, object: m{"status": m{"replicas": int64(1)}}},
+		{rule: "daemonset-pods-not-ready", kind: DaemonSetsResource, object: m{"status": m{"numberReady": int64(2), "desiredNumberScheduled": int64(3)}}},
+		{rule: "daemonset-misscheduled", kind: DaemonSetsResource, object: m{"status": m{"numberMisscheduled": int64(1)}}},
+		{rule: "node-not-ready", kind: NodesResource, object: condition("Ready", "Unknown", nil)},
+		{rule: "node-pressure", kind: NodesResource, object: condition("MemoryPressure", "True", nil)},
+		{rule: "node-network-unavailable", kind: NodesResource, object: condition("NetworkUnavailable", "True", nil)},
+		{rule: "node-cordoned", kind: NodesResource, object: m{"spec": m{"unschedulable": true}}},
+		{rule: "node-memory-high", kind: NodesResource, object: m{"spec": m{}}, usage: &UsageMetrics{MemoryPercent: 93, CPUPercent: 10},
+			message: "Memory usage at 93% of allocatable"},
+		{rule: "node-cpu-high", kind: NodesResource, object: m{"spec": m{}}, usage: &UsageMetrics{MemoryPercent: 10, CPUPercent: 99},
+			message: "CPU usage at 99% of allocatable"},
+		{rule: "loadbalancer-pending", kind: ServicesResource, object: m{"spec": m{"type": "LoadBalancer"}}},
+		{rule: "service-no-endpoints", kind: ServicesResource, object: m{"spec": m{"selector": m{"app": "web"}}},
+			data: map[string]interface{}{"endpoints": map[string]interface{}{"ready": int64(0), "total": int64(1)}}},
+		{rule: "ingress-no-address", kind: IngressResource, object: m{"status": m{"loadBalancer": m{}}}},
+		{rule: "pvc-pending", kind: PersistentVolumeClaimsResource, object: m{"status": m{"phase": "Pending"}}},
+		{rule: "job-failed-pods", kind: JobsResource, object: m{"spec": m{"completions": int64(2)}, "status": m{"succeeded": int64(1), "failed": int64(3)}},
+			message: "Job has 3 failed pods"},
+		{rule: "job-failed", kind: JobsResource, object: condition("Failed", "True", m{"reason": "BackoffLimitExceeded"}),
+			message: "Job failed: BackoffLimitExceeded"},
+		{rule: "job-suspended", kind: JobsResource, object: condition("Suspended", "True", nil)},
+		{rule: "cronjob-invalid-schedule", kind: CronJobsResource, object: m{"spec": m{"schedule": "61 * * * *"}},
+			data:    map[string]interface{}{"nextRun": map[string]interface{}{"error": "minute: 61 out of range"}},
+			message: "Cannot compute next run: minute: 61 out of range"},
+		{rule: "cronjob-last-run-failed", kind: CronJobsResource, object: m{"status": m{
+			"lastScheduleTime": "2026-01-01T00:30:00Z", "lastSuccessfulTime": "2026-01-01T00:00:00Z"}}},
+		{rule: "route-not-admitted", kind: RoutesResource, object: m{"status": m{"ingress": l{condition("Admitted", "False", m{"message": "host taken"})["status"]}}},
+			message: "Route not admitted: host taken"},
+		{rule: "project-not-active", kind: ProjectsResource, object: m{"status": m{"phase": "Terminating"}}, message: "Project is in Terminating state"},
+	}
+	
+	covered := map[string]bool{}
+	for _, tt := range tests {
+		covered[tt.rule] = true
+		res := []K8sResource{{Name: "sample", ResourceType: tt.kind, Object: &unstructured.Unstructured{Object: tt.object}, RuleData: tt.data, Usage: tt.usage}}
+		rules.apply(res, now)
+		var fired []string
+		found := false
+		for _, f := range res[0].Findings {
+			fired = append(fired, f.Rule)
+			if f.Rule == tt.rule {
+				found = true
+				if tt.message != "" && f.Message != tt.message {
+					t.Errorf("%s: message %q, want %q", tt.rule, f.Message, tt.message)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("%s did not fire on its sample; fired: %v", tt.rule, fired)
+		}
+	}
+	for _, rule := range builtinRules {
+		if !covered[rule.Name] {
+			t.Errorf("built-in rule %s has no sample", rule.Name)
+		}
+	}
+	
+	// Healthy objects raise nothing
+	healthy := []K8sResource{
+		{ResourceType: PodsResource, Object: &unstructured.Unstructured{Object: m{"metadata": created,
+			"spec":   m{"containers": l{m{"image": "web:1.2"}}},
+			"status": m{"phase": "Running", "conditions": l{m{"type": "Ready", "status": "True"}},
+				"containerStatuses": l{m{"restartCount": int64(0), "state": m{"running": m{}}}}}}},
+			Usage: &UsageMetrics{Containers: []UsageMetrics{{Name: "app", MemoryLimitPct: -1, CPULimitPct: 40}}}},
+		{ResourceType: DeploymentsResource, Object: &unstructured.Unstructured{Object: m{"status": m{"replicas": int64(2), "readyReplicas": int64(2),
+			"conditions": l{m{"type": "Available", "status": "True"}, m{"type": "Progressing", "status": "True", "reason": "NewReplicaSetAvailable"}}}}}},
+		{ResourceType: JobsResource, Object: &unstructured.Unstructured{Object: m{"spec": m{}, "status": m{"succeeded": int64(1), "failed": int64(2)}}}},
+		{ResourceType: CronJobsResource, Object: &unstructured.Unstructured{Object: m{"status": m{
+			"lastScheduleTime": "2026-01-01T00:30:00Z", "lastSuccessfulTime": "2026-01-01T00:31:00Z"}}}},
+		{ResourceType: CronJobsResource, Object: &unstructured.Unstructured{Object: m{"status": m{
+			"lastScheduleTime": "2026-01-01T00:30:00Z", "active": l{m{"name": "run"}}}}}},
+		{ResourceType: ServicesResource, Object: &unstructured.Unstructured{Object: m{"spec": m{"type": "ClusterIP", "selector": m{"app": "web"}}}}},
+		{ResourceType: NodesResource, Object: &unstructured.Unstructured{Object: m{"spec": m{},
+			"status": m{"conditions": l{m{"type": "Ready", "status": "True"}, m{"type": "DiskPressure", "status": "False"}}}}},
+			Usage: &UsageMetrics{CPUPercent: 89, MemoryPercent: -1}},
+	}
+	rules.apply(healthy, now)
+	for _, res := range healthy {
+		if len(res.Findings) > 0 {
+			t.Errorf("healthy %v: unexpected findings %v", res.ResourceType, res.Findings)
+		}
+	}
+}
+
+// cronBits returns the bit set of a cron field allowing values
+func cronBits(values ...int) uint64 {
+	var bits uint64
+	for _, v := range values {
+		bits |= 1 << uint(v)
+	}
+	return bits
+}
+
+func TestParseCronField(t *testing.T) {
+	tests := []struct {
+		field     string
+		low, high int
+		names     map[string]int
+		want      uint64
+		err       string
+	}{
+		{field: "*", low: 0, high: 59, want: 1<<60 - 1},
+		{field: "?", low: 1, high: 31, want: 1<<32 - 2},
+		{field: "5", low: 0, high: 59, want: cronBits(5)},
+		{field: "1,3,5", low: 0, high: 59, want: cronBits(1, 3, 5)},
+		{field: "1-5", low: 0, high: 59, want: cronBits(1, 2, 3, 4, 5)},
+		{field: "*/15", low: 0, high: 59, want: cronBits(0, 15, 30, 45)},
+		{field: "1-10/3", low: 0, high: 59, want: cronBits(1, 4, 7, 10)},
+		{field: "5/20", low: 0, high: 59, want: cronBits(5, 25, 45)},
+		{field: "*/5", low: 1, high: 12, want: cronBits(1, 6, 11)},
+		{field: "0-4,22-23", low: 0, high: 23, want: cronBits(0, 1, 2, 3, 4, 22, 23)},
+		{field: "jan,mar-apr", low: 1, high: 12, names: cronMonthNames, want: cronBits(1, 3, 4)},
+		{field: "MON-fri", low: 0, high: 7, names: cronDayNames, want: cronBits(1, 2, 3, 4, 5)},
+		{field: "60", low: 0, high: 59, err: "out of range"},
+		{field: "0", low: 1, high: 31, err: "out of range"},
+		{field: "5-1", low: 0, high: 59, err: "out of range"},
+		{field: "*/0", low: 0, high: 59, err: "invalid step"},
+		{field: "*/x", low: 0, high: 59, err: "invalid step"},
+		{field: "abc", low: 0, high: 59, err: "invalid value"},
+		{field: "1-2-3", low: 0, high: 59, err: "invalid value"},
+		{field: "", low: 0, high: 59, err: "invalid value"},
+		{field: "jan", low: 1, high: 12, err: "invalid value"},
+	}
+	for _, tt := range tests {
+		got, err := parseCronField(tt.field, tt.low, tt.high, tt.names)
+		if tt.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("%q: error %v, want %q", tt.field, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: %v", tt.field, err)
+		} else if got != tt.want {
+			t.Errorf("%q = %b, want %b", tt.field, got, tt.want)
+		}
+	}
+}
+
+func TestParseCronSchedule(t *testing.T) {
+	tests := []struct {
+		spec, zone string
+		same       string // A plain schedule it must parse to
+		err        string
+	}{
+		{spec: "@hourly", zone: "UTC", same: "0 * * * *"},
+		{spec: "@daily", zone: "UTC", same: "0 0 * * *"},
+		{spec: "@midnight", zone: "UTC", same: "0 0 * * *"},
+		{spec: "@weekly", zone: "UTC", same: "0 0 * * 0"},
+		{spec: "@monthly", zone: "UTC", same: "0 0 1 * *"},
+		{spec: "@yearly", zone: "UTC", same: "0 0 1 1 *"},
+		{spec: "@annually", zone: "UTC", same: "0 0 1 1 *"},
+		{spec: "0 0 * * 7", zone: "UTC", same: "0 0 * * 0,7"},
+		{spec: "0 0 * * sun", zone: "UTC", same: "0 0 * * 0"},
+		{spec: "  0 0 ? * MON  ", zone: "UTC", same: "0 0 * * 1"},
+		{spec: "CRON_TZ=Europe/Berlin 0 9 * * *", zone: "Europe/Berlin", same: "0 9 * * *"},
+		{spec: "TZ=Asia/Tokyo @daily", zone: "Asia/Tokyo", same: "0 0 * * *"},
+		{spec: "CRON_TZ=Nowhere/City 0 * * * *", err: `unknown time zone "Nowhere/City"`},
+		{spec: "CRON_TZ=UTC", err: "missing schedule after CRON_TZ=UTC"},
+		{spec: "* * * *", err: "expected 5 fields"},
+		{spec: "0 0 * * * *", err: "expected 5 fields"},
+		{spec: "@every 5m", err: "expected 5 fields"},
+		{spec: "0 24 * * *", err: "out of range"},
+		{spec: "0 0 32 * *", err: "out of range"},
+		{spec: "0 0 * 13 *", err: "out of range"},
+		{spec: "0 0 * * 8", err: "out of range"},
+	}
+	for _, tt := range tests {
+		sched, loc, err := parseCronSchedule(tt.spec, time.UTC)
+		if tt.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("%q: error %v, want %q", tt.spec, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: %v", tt.spec, err)
+			continue
+		}
+		if loc.String() != tt.zone {
+			t.Errorf("%q: zone %s, want %s", tt.spec, loc, tt.zone)
+		}
+		want, _, err := parseCronSchedule(tt.same, time.UTC)
+		if err != nil {
+			t.Fatalf("%q: %v", tt.same, err)
+		}
+		if sched.minute != want.minute || sched.hour != want.hour || sched.dom != want.dom || sched.month != want.month ||
+			sched.dow|1<<7 != want.dow|1<<7 || sched.domStar != want.domStar || sched.dowStar != want.dowStar {
+			t.Errorf("%q = %+v, want %+v", tt.spec, sched, want)
+		}
+	}
+}
+
+func TestCronScheduleNext(t *testing.T) {
+	tests := []struct {
+		schedule, zone, from string
+		want                 string // "" when the schedule never fires
+	}{
+		// 2026-01-01 is a Thursday
+		{"*/15 * * * *", "UTC", "2026-01-01 10:07", "2026-01-01 10:15 UTC"},
+		{"*/15 * * * *", "UTC", "2026-01-01 10:15", "2026-01-01 10:30 UTC"},
+		{"0 * * * *", "UTC", "2026-01-01 23:59", "2026-01-02 00:00 UTC"},
+		{"30 9-17/4 * * *", "UTC", "2026-01-01 14:00", "2026-01-01 17:30 UTC"},
+		{"@monthly", "UTC", "2026-01-31 12:00", "2026-02-01 00:00 UTC"},
+		{"@yearly", "UTC", "2026-06-01 00:00", "2027-01-01 00:00 UTC"},
+		{"0 0 29 2 *", "UTC", "2026-01-01 00:00", "2028-02-29 00:00 UTC"},
+		
+		// Day of month and day of week are OR'ed when both are restricted
+		{"0 0 13 * 5", "UTC", "2026-01-01 00:00", "2026-01-02 00:00 UTC"},
+		{"0 0 13 * 5", "UTC", "2026-01-10 00:00", "2026-01-13 00:00 UTC"},
+		{"0 0 13 * *", "UTC", "2026-01-01 00:00", "2026-01-13 00:00 UTC"},
+		{"0 0 * * 5", "UTC", "2026-01-10 00:00", "2026-01-16 00:00 UTC"},
+		{"0 0 ? * 5", "UTC", "2026-01-10 00:00", "2026-01-16 00:00 UTC"},
+		
+		// Sunday as 0 or 7
+		{"0 0 * * 7", "UTC", "2026-01-01 00:00", "2026-01-04 00:00 UTC"},
+		{"0 0 * * 0", "UTC", "2026-01-01 00:00", "2026-01-04 00:00 UTC"},
+		{"0 0 * * 6-7", "UTC", "2026-01-01 00:00", "2026-01-03 00:00 UTC"},
+		
+		// Time zones, from the argument or a CRON_TZ= prefix
+		{"0 9 * * *", "Europe/Berlin", "2026-01-01 09:00", "2026-01-02 09:00 CET"},
+		{"CRON_TZ=America/New_York 0 9 * * *", "UTC", "2026-01-01 15:00", "2026-01-02 09:00 EST"},
+		
+		// DST: 02:30 does not exist on 2026-03-08 in New York and is skipped; hourly runs
+		// follow the wall clock across both transitions
+		{"30 2 * * *", "America/New_York", "2026-03-08 06:00", "2026-03-09 02:30 EDT"},
+		{"0 * * * *", "America/New_York", "2026-03-08 06:30", "2026-03-08 03:00 EDT"},
+		{"0 3 * * *", "America/New_York", "2026-03-08 06:00", "2026-03-08 03:00 EDT"},
+		{"0 * * * *", "America/New_York", "2026-11-01 05:30", "2026-11-01 01:00 EST"},
+		{"0 12 * * *", "America/New_York", "2026-10-31 17:00", "2026-11-01 12:00 EST"},
+		
+		// Schedules that never fire
+		{"0 0 30 2 *", "UTC", "2026-01-01 00:00", ""},
+		{"0 0 31 4,6,9,11 *", "UTC", "2026-01-01 00:00", ""},
+	}
+	for _, tt := range tests {
+		from, err := time.ParseInLocation("2006-01-02 15:04", tt.from, time.UTC)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.from, err)
+		}
+		next, err := nextCronRun(tt.schedule, tt.zone, from)
+		if err != nil {
+			t.Errorf("%q: %v", tt.schedule, err)
+			continue
+		}
+		got := ""
+		if !next.IsZero() {
+			got = next.Format("2006-01-02 15:04 MST")
+		}
+		if got != tt.want {
+			t.Errorf("%q in %s after %s UTC = %q, want %q", tt.schedule, tt.zone, tt.from, got, tt.want)
+		}
+	}
+}
+
+func TestReadTail(t *testing.T) {
+	tests := []struct {
+		name, input string
+		limit       int
+		want        string
+	}{
+		{"short", "a\nb\n", 16, "a\nb\n"},
+		{"exact", "0123\n567\n", 9, "0123\n567\n"},
+		{"cut at a line", "first line\nsecond\nthird\n", 12, "third\n"},
+		{"cut inside the last line", "aaaa\nbbbbbbbbbbbb", 8, "bbbbbbbb"},
+		{"empty", "", 4, ""},
+	}
+	for _, tt := range tests {
+		// A dump directory hands over files, an archive a plain stream
+		path := filepath.Join(t.TempDir(), "current.log")
+		if err := os.WriteFile(path, []byte(tt.input), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fromFile, err := readTail(file, tt.limit)
+		file.Close()
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		fromStream, err := readTail(iotest.OneByteReader(strings.NewReader(tt.input)), tt.limit)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if fromFile != tt.want || fromStream != tt.want {
+			t.Errorf("%s: file %q, stream %q, want %q", tt.name, fromFile, fromStream, tt.want)
+		}
+	}
+}
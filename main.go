@@ -481,46 +481,6 @@ func (m Model) loadEventsCmd() tea.Cmd {
 	}
 }
 
-// analyzeResourceHealth checks for errors and warnings in resources
-func (m Model) analyzeResourceHealth(resource *K8sResource) {
-	resource.Errors = []string{}
-	resource.Warnings = []string{}
-	
-	switch resource.ResourceType {
-	case PodsResource:
-		// Analyze pod health
-		if resource.Status == "Failed" || resource.Status == "Error" {
-			resource.Errors = append(resource.Errors, "Pod is in failed state")
-		}
-		if resource.Status == "Pending" {
-			resource.Warnings = append(resource.Warnings, "Pod is stuck in pending state")
-		}
-		if restarts := resource.Details["Restarts"]; restarts != "" && restarts != "0" {
-			if r, err := strconv.Atoi(restarts); err == nil && r > 5 {
-				resource.Warnings = append(resource.Warnings, fmt.Sprintf("High restart count: %s", restarts))
-			}
-		}
-		
-	case DeploymentsResource:
-		// Analyze deployment health
-		if resource.Status == "NotReady" {
-			resource.Errors = append(resource.Errors, "Deployment replicas not ready")
-		}
-		
-	case ServicesResource:
-		// Analyze service health
-		if endpoints := resource.Details["Endpoints"]; endpoints == "0" {
-			resource.Warnings = append(resource.Warnings, "Service has no endpoints")
-		}
-		
-	case JobsResource:
-		// Analyze job health
-		if resource.Status == "Failed" {
-			resource.Errors = append(resource.Errors, "Job failed to complete")
-		}
-	}
-}
-
 // Resource loading functions with enhanced error detection
 
 func (m Model) loadNodes() ([]K8sResource, error) {